@@ -0,0 +1,154 @@
+package neonslider
+
+import (
+	"sync"
+	"time"
+
+	"fyne.io/fyne/v2"
+)
+
+// DefaultFrameRate - частота кадров общего планировщика анимации по умолчанию
+const DefaultFrameRate = 60
+
+// animationTarget - объект, кадры которого обновляет общий планировщик
+type animationTarget interface {
+	// animateFrame вызывается в главном потоке Fyne на каждом кадре
+	animateFrame()
+}
+
+// animationScheduler - единый тикер, который обновляет все зарегистрированные слайдеры.
+// Горутина тикера запускается при первой регистрации и завершается, когда
+// зарегистрированных объектов не остаётся.
+type animationScheduler struct {
+	mu        sync.Mutex
+	targets   map[animationTarget]struct{}
+	frameRate int
+	startTime time.Time     // Общая точка отсчёта, чтобы фазы слайдеров на системных часах совпадали
+	stop      chan struct{} // Канал остановки горутины тикера (nil - тикер не запущен)
+	rate      chan int      // Канал смены частоты кадров для работающего тикера
+
+	// dispatch передаёт кадр в главный поток Fyne. Тесты заменяют его до запуска
+	// тикеров, чтобы кадры не шли параллельно с тестом, и вызывают frame вручную.
+	dispatch func(func())
+}
+
+// scheduler - общий планировщик анимации пакета
+var scheduler = &animationScheduler{
+	targets:   make(map[animationTarget]struct{}),
+	frameRate: DefaultFrameRate,
+	startTime: time.Now(),
+	dispatch:  fyne.Do,
+}
+
+// SetFrameRate задаёт частоту кадров анимации для всех слайдеров.
+// Значения меньше 1 заменяются на DefaultFrameRate.
+func SetFrameRate(fps int) {
+	scheduler.setFrameRate(fps)
+}
+
+// FrameRate возвращает текущую частоту кадров анимации
+func FrameRate() int {
+	scheduler.mu.Lock()
+	defer scheduler.mu.Unlock()
+	return scheduler.frameRate
+}
+
+// frameInterval переводит частоту кадров в интервал тикера
+func frameInterval(fps int) time.Duration {
+	return time.Second / time.Duration(fps)
+}
+
+func (s *animationScheduler) setFrameRate(fps int) {
+	if fps < 1 {
+		fps = DefaultFrameRate
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.frameRate == fps {
+		return
+	}
+	s.frameRate = fps
+	if s.stop != nil {
+		// Заменяем ещё не прочитанное значение, чтобы не блокироваться
+		select {
+		case <-s.rate:
+		default:
+		}
+		s.rate <- fps
+	}
+}
+
+// register добавляет объект в планировщик и при необходимости запускает тикер.
+// Повторная регистрация ничего не делает и возвращает false.
+func (s *animationScheduler) register(target animationTarget) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.targets[target]; ok {
+		return false
+	}
+	s.targets[target] = struct{}{}
+	if s.stop == nil {
+		s.stop = make(chan struct{})
+		s.rate = make(chan int, 1)
+		go s.run(s.stop, s.rate, s.frameRate, s.dispatch)
+	}
+	return true
+}
+
+// unregister убирает объект из планировщика и останавливает тикер, если он больше не нужен
+func (s *animationScheduler) unregister(target animationTarget) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.targets, target)
+	if len(s.targets) == 0 && s.stop != nil {
+		close(s.stop)
+		s.stop = nil
+		s.rate = nil
+	}
+}
+
+// isRegistered сообщает, обновляет ли планировщик указанный объект
+func (s *animationScheduler) isRegistered(target animationTarget) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	_, ok := s.targets[target]
+	return ok
+}
+
+// run - цикл тикера, работает до закрытия stop
+func (s *animationScheduler) run(stop chan struct{}, rate chan int, fps int, dispatch func(func())) {
+	ticker := time.NewTicker(frameInterval(fps))
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-stop:
+			return
+		case fps := <-rate:
+			ticker.Reset(frameInterval(fps))
+		case <-ticker.C:
+			dispatch(s.frame)
+		}
+	}
+}
+
+// frame обновляет все зарегистрированные объекты в главном потоке
+func (s *animationScheduler) frame() {
+	s.mu.Lock()
+	targets := make([]animationTarget, 0, len(s.targets))
+	for target := range s.targets {
+		targets = append(targets, target)
+	}
+	s.mu.Unlock()
+
+	for _, target := range targets {
+		// Объект мог быть снят с регистрации, пока кадр ждал в очереди
+		if s.isRegistered(target) {
+			target.animateFrame()
+		}
+	}
+}
@@ -0,0 +1,196 @@
+package neonslider
+
+import (
+	"fmt"
+	"os"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/test"
+	"fyne.io/fyne/v2/widget"
+)
+
+func TestMain(m *testing.M) {
+	// Тикеры планировщика работают, но кадры не доставляют: иначе в тестовом драйвере
+	// Fyne кадр выполняется на горутине тикера одновременно с тестом.
+	// Тесты, которым нужен кадр, вызывают scheduler.frame или StepAnimation сами.
+	scheduler.dispatch = func(func()) {}
+	os.Exit(m.Run())
+}
+
+// renderSlider создаёт рендерер слайдера и уничтожает его в конце теста,
+// чтобы слайдер не оставался в планировщике
+func renderSlider(t *testing.T, slider *NeonSlider) *neonSliderRenderer {
+	t.Helper()
+	return test.TempWidgetRenderer(t, slider).(*neonSliderRenderer)
+}
+
+// waitForGoroutines ждёт, пока число горутин не опустится до want
+func waitForGoroutines(t *testing.T, want int) {
+	t.Helper()
+
+	deadline := time.Now().Add(2 * time.Second)
+	for runtime.NumGoroutine() > want {
+		if time.Now().After(deadline) {
+			t.Fatalf("goroutines: got %d, want %d", runtime.NumGoroutine(), want)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+// assertSchedulerIdle проверяет, что в планировщике нет слайдеров и тикер остановлен
+func assertSchedulerIdle(t *testing.T) {
+	t.Helper()
+
+	scheduler.mu.Lock()
+	defer scheduler.mu.Unlock()
+	if len(scheduler.targets) != 0 {
+		t.Errorf("scheduler targets: got %d, want 0", len(scheduler.targets))
+	}
+	if scheduler.stop != nil {
+		t.Error("scheduler ticker is still running")
+	}
+}
+
+// countRunLoops считает горутины тикера планировщика (run запускается только из register)
+func countRunLoops() int {
+	buf := make([]byte, 1<<20)
+	stacks := string(buf[:runtime.Stack(buf, true)])
+	return strings.Count(stacks, "created by neonslider.(*animationScheduler).register")
+}
+
+// waitForRunLoops ждёт, пока число горутин тикера не станет want
+func waitForRunLoops(t *testing.T, want int) {
+	t.Helper()
+
+	deadline := time.Now().Add(2 * time.Second)
+	for countRunLoops() != want {
+		if time.Now().After(deadline) {
+			t.Fatalf("animation loops: got %d, want %d", countRunLoops(), want)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestDestroyedSlidersStopAnimation(t *testing.T) {
+	test.NewApp()
+	start := runtime.NumGoroutine()
+
+	t.Run("render", func(t *testing.T) {
+		sliders := []*NeonSlider{New(0, 100), NewWithStep(0, 10, 1), NewWithColor(0, 1, PinkCyber)}
+		box := container.NewVBox()
+		for _, slider := range sliders {
+			box.Add(slider)
+		}
+		w := test.NewWindow(box)
+		defer w.Close()
+
+		for _, slider := range sliders {
+			// Рендерер уничтожается при завершении подтеста, как при удалении из кэша Fyne
+			renderSlider(t, slider)
+			if !slider.IsAnimating() {
+				t.Fatal("rendered slider is not animating")
+			}
+		}
+
+		// Убираем слайдеры из окна
+		w.SetContent(widget.NewLabel("empty"))
+	})
+
+	assertSchedulerIdle(t)
+	waitForGoroutines(t, start)
+}
+
+// animatedSlider - общий жизненный цикл анимации NeonSlider и NeonRangeSlider
+type animatedSlider interface {
+	animationTarget
+	StartAnimation()
+	StopAnimation()
+	PauseAnimation()
+	ResumeAnimation()
+	IsAnimating() bool
+}
+
+func TestAnimationLifecycle(t *testing.T) {
+	for _, slider := range []animatedSlider{New(0, 100), NewRange(0, 100)} {
+		t.Run(fmt.Sprintf("%T", slider), func(t *testing.T) {
+			testAnimationLifecycle(t, slider)
+		})
+	}
+}
+
+func testAnimationLifecycle(t *testing.T, slider animatedSlider) {
+	if slider.IsAnimating() {
+		t.Fatal("new slider is animating before StartAnimation")
+	}
+
+	slider.StartAnimation()
+	if !slider.IsAnimating() {
+		t.Fatal("StartAnimation did not start the animation")
+	}
+
+	slider.PauseAnimation()
+	if slider.IsAnimating() {
+		t.Error("paused slider reports IsAnimating")
+	}
+	if !scheduler.isRegistered(slider) {
+		t.Error("PauseAnimation removed the slider from the scheduler")
+	}
+
+	slider.ResumeAnimation()
+	if !slider.IsAnimating() {
+		t.Error("ResumeAnimation did not resume the animation")
+	}
+
+	slider.PauseAnimation()
+	slider.StopAnimation()
+	if slider.IsAnimating() {
+		t.Error("stopped slider reports IsAnimating")
+	}
+	assertSchedulerIdle(t)
+
+	// После остановки пауза сброшена: повторный запуск сразу анимирует
+	slider.StartAnimation()
+	if !slider.IsAnimating() {
+		t.Error("StartAnimation after StopAnimation did not animate")
+	}
+	slider.StopAnimation()
+	assertSchedulerIdle(t)
+}
+
+func TestRecreatedRendererKeepsOneAnimationLoop(t *testing.T) {
+	test.NewApp()
+	waitForRunLoops(t, 0) // Тикеры предыдущих тестов могут ещё завершаться
+	slider := New(0, 100)
+
+	renderers := make([]*neonSliderRenderer, 3)
+	for i := range renderers {
+		renderers[i] = slider.CreateRenderer().(*neonSliderRenderer)
+	}
+
+	scheduler.mu.Lock()
+	targets := len(scheduler.targets)
+	scheduler.mu.Unlock()
+	if targets != 1 {
+		t.Errorf("scheduler targets: got %d, want 1", targets)
+	}
+	waitForRunLoops(t, 1)
+
+	// Уничтожение устаревших рендереров не должно останавливать анимацию
+	for _, stale := range renderers[:len(renderers)-1] {
+		stale.Destroy()
+	}
+	if !slider.IsAnimating() {
+		t.Fatal("destroying a stale renderer stopped the animation")
+	}
+
+	renderers[len(renderers)-1].Destroy()
+	if slider.IsAnimating() {
+		t.Error("destroying the current renderer did not stop the animation")
+	}
+	assertSchedulerIdle(t)
+	waitForRunLoops(t, 0)
+}
@@ -0,0 +1,192 @@
+package neonslider
+
+import (
+	"math"
+	"sync"
+)
+
+// AnimationFrame - состояние свечения на одном кадре анимации
+type AnimationFrame struct {
+	Intensity float64 // Интенсивность свечения (между MinIntensity и MaxIntensity)
+	Pulse     float64 // Фаза пульсации - дополнительная яркость заливки и ползунка
+	Shimmer   float64 // Фаза мерцания - быстрые всплески яркости
+}
+
+// Animator рассчитывает свечение слайдера по прошедшему времени и цветовой схеме.
+// Реализации должны быть чистыми функциями времени: один и тот же elapsed
+// даёт один и тот же кадр.
+type Animator interface {
+	Animate(elapsed float64, colors NeonColors) AnimationFrame
+}
+
+// AnimatorFunc позволяет использовать обычную функцию как Animator
+type AnimatorFunc func(elapsed float64, colors NeonColors) AnimationFrame
+
+// Animate вызывает f(elapsed, colors)
+func (f AnimatorFunc) Animate(elapsed float64, colors NeonColors) AnimationFrame {
+	return f(elapsed, colors)
+}
+
+// КАРДИНАЛЬНО УЛУЧШЕННЫЕ встроенные анимации для большей заметности
+
+// WaveAnimator - волновая анимация с многослойными эффектами
+type WaveAnimator struct{}
+
+// Animate рассчитывает кадр волновой анимации
+func (WaveAnimator) Animate(elapsed float64, colors NeonColors) AnimationFrame {
+	baseTime := elapsed * colors.AnimationSpeed * colors.WaveFrequency
+
+	// Основная волна
+	mainWave := math.Sin(baseTime * 1.5)
+	// Вторичная волна для сложности
+	secondWave := math.Sin(baseTime*2.3) * 0.4
+	// Быстрое мерцание для живости
+	flicker := math.Sin(baseTime*8.0) * 0.15
+
+	// Комбинируем все волны
+	combinedWave := (mainWave + secondWave + flicker) * colors.WaveAmplitude
+
+	// Нормализуем и применяем easing
+	normalizedWave := (combinedWave + 1.0) / 2.0
+	smoothWave := smootherstep(normalizedWave)
+
+	pulseRange := colors.MaxIntensity - colors.MinIntensity
+
+	return AnimationFrame{
+		Intensity: colors.MinIntensity + pulseRange*smoothWave,
+		// Дополнительные фазы для рендера
+		Pulse:   smoothWave * 0.4,                             // Увеличено
+		Shimmer: ((math.Sin(baseTime*5.5) + 1.0) / 2.0) * 0.3, // Увеличено
+	}
+}
+
+// PulseAnimator - пульсирующая анимация с резкими всплесками
+type PulseAnimator struct{}
+
+// Animate рассчитывает кадр пульсирующей анимации
+func (PulseAnimator) Animate(elapsed float64, colors NeonColors) AnimationFrame {
+	pulseTime := elapsed * colors.AnimationSpeed * 2.5 // Ускорено
+
+	// Основная пульсация
+	rawPulse := math.Sin(pulseTime * math.Pi)
+	normalizedPulse := (rawPulse + 1.0) / 2.0
+	smoothPulse := easeInOutCubic(normalizedPulse)
+
+	// Добавляем быстрые всплески
+	burstTime := elapsed * colors.AnimationSpeed * 7.0
+	burst := math.Max(0, math.Sin(burstTime)) * 0.3
+
+	pulseRange := colors.MaxIntensity - colors.MinIntensity
+	basePulse := smoothPulse * colors.PulseStrength
+
+	return AnimationFrame{
+		Intensity: colors.MinIntensity + pulseRange*(basePulse+burst),
+		// Сильные эффекты для пульсации
+		Pulse:   (smoothPulse + burst) * 0.5, // Увеличено
+		Shimmer: burst * 0.4,                 // Новый эффект
+	}
+}
+
+// BreathingAnimator - "дыхание" с медленными мощными переходами
+type BreathingAnimator struct{}
+
+// Animate рассчитывает кадр анимации "дыхания"
+func (BreathingAnimator) Animate(elapsed float64, colors NeonColors) AnimationFrame {
+	breathTime := elapsed * colors.BreathingSpeed
+
+	// Медленное основное дыхание
+	rawBreath := math.Sin(breathTime * math.Pi * 0.4) // Медленнее
+	normalizedBreath := (rawBreath + 1.0) / 2.0
+	// Тройное сглаживание для супер-плавности
+	smoothBreath := smootherstep(smootherstep(smootherstep(normalizedBreath)))
+
+	// Добавляем тонкое мерцание на пиках
+	peakFlicker := 0.0
+	if smoothBreath > 0.8 {
+		flickerTime := elapsed * colors.AnimationSpeed * 12.0
+		peakFlicker = math.Sin(flickerTime) * 0.1 * (smoothBreath - 0.8) * 5.0
+	}
+
+	pulseRange := colors.MaxIntensity - colors.MinIntensity
+	breathEffect := smoothBreath * colors.BreathingDepth
+
+	return AnimationFrame{
+		Intensity: colors.MinIntensity + pulseRange*(breathEffect+peakFlicker),
+		// Мягкие дополнительные эффекты
+		Pulse:   smoothBreath * 0.3, // Мягко
+		Shimmer: peakFlicker * 0.5,  // Только на пиках
+	}
+}
+
+// animationEntry - зарегистрированная анимация
+type animationEntry struct {
+	name     string
+	animator Animator
+}
+
+// animationRegistry хранит анимации по AnimationType.
+// Индекс записи совпадает со значением AnimationType.
+var animationRegistry = struct {
+	sync.RWMutex
+	entries []animationEntry
+}{
+	entries: []animationEntry{
+		AnimationWave:      {name: "Волновая", animator: WaveAnimator{}},
+		AnimationPulse:     {name: "Пульсация", animator: PulseAnimator{}},
+		AnimationBreathing: {name: "Дыхание", animator: BreathingAnimator{}},
+	},
+}
+
+// RegisterAnimation добавляет пользовательскую анимацию и возвращает её тип,
+// который можно передать в SetAnimationType или NewWithSettings.
+func RegisterAnimation(name string, animator Animator) AnimationType {
+	animationRegistry.Lock()
+	defer animationRegistry.Unlock()
+
+	animationRegistry.entries = append(animationRegistry.entries,
+		animationEntry{name: name, animator: animator})
+	return AnimationType(len(animationRegistry.entries) - 1)
+}
+
+// AnimatorFor возвращает анимацию для указанного типа или nil, если тип не зарегистрирован
+func AnimatorFor(anim AnimationType) Animator {
+	entry, ok := lookupAnimation(anim)
+	if !ok {
+		return nil
+	}
+	return entry.animator
+}
+
+// resolveAnimator выбирает анимацию: явно заданную, из реестра или волновую по умолчанию
+func resolveAnimator(custom Animator, anim AnimationType) Animator {
+	if custom != nil {
+		return custom
+	}
+	if animator := AnimatorFor(anim); animator != nil {
+		return animator
+	}
+	return WaveAnimator{} // По умолчанию волновая
+}
+
+// AnimationTypes возвращает все зарегистрированные типы анимации в порядке регистрации
+func AnimationTypes() []AnimationType {
+	animationRegistry.RLock()
+	defer animationRegistry.RUnlock()
+
+	types := make([]AnimationType, len(animationRegistry.entries))
+	for i := range types {
+		types[i] = AnimationType(i)
+	}
+	return types
+}
+
+// lookupAnimation ищет запись реестра по типу анимации
+func lookupAnimation(anim AnimationType) (animationEntry, bool) {
+	animationRegistry.RLock()
+	defer animationRegistry.RUnlock()
+
+	if anim < 0 || int(anim) >= len(animationRegistry.entries) {
+		return animationEntry{}, false
+	}
+	return animationRegistry.entries[anim], true
+}
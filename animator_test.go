@@ -0,0 +1,92 @@
+package neonslider
+
+import (
+	"math"
+	"slices"
+	"testing"
+	"time"
+)
+
+// glowTolerance - допуск сравнения кадров, рассчитанных на ручных часах
+const glowTolerance = 1e-12
+
+// glowAt рассчитывает кадр анимации слайдера через elapsed после старта ручных часов
+func glowAt(slider *NeonSlider, elapsed time.Duration) (intensity, pulse, shimmer float64) {
+	clock := NewManualClock(time.Unix(0, 0))
+	slider.SetClock(clock)
+	clock.Advance(elapsed)
+	slider.StepAnimation()
+	return slider.Glow()
+}
+
+func TestStepAnimationWithManualClock(t *testing.T) {
+	tests := []struct {
+		anim                      AnimationType
+		elapsed                   time.Duration
+		intensity, pulse, shimmer float64
+	}{
+		{AnimationWave, 0, 0.75, 0.2, 0.15},
+		{AnimationWave, 500 * time.Millisecond, 0.954484196804074, 0.36358735744326, 0.271274460572939},
+		{AnimationWave, 1250 * time.Millisecond, 0.998658117863384, 0.398926494290707, 0.0441689511644412},
+		{AnimationWave, 3 * time.Second, 0.58137194546517, 0.0650975563721364, 0.238811027206084},
+		{AnimationPulse, 0, 0.625, 0.25, 0},
+		{AnimationPulse, 500 * time.Millisecond, 0.675248222523386, 0.300248222523386, 0.0401985780187086},
+		{AnimationPulse, 1250 * time.Millisecond, 0.848547989807818, 0.598547989807818, 0.0788383918462547},
+		{AnimationPulse, 3 * time.Second, 0.625, 0.25, 0},
+		{AnimationBreathing, 0, 0.6, 0.15, 0},
+		{AnimationBreathing, 500 * time.Millisecond, 0.650192645111217, 0.299994643969587, -0.0498037842018406},
+		{AnimationBreathing, 1250 * time.Millisecond, 0.673171354099978, 0.3, -0.0268286459000219},
+		{AnimationBreathing, 3 * time.Second, 0.663746695050634, 0.255076702734502, -0.00630444010570078},
+	}
+
+	for _, tt := range tests {
+		slider := NewWithSettings(0, 100, GreenCyber, DragFullTrack, tt.anim)
+		intensity, pulse, shimmer := glowAt(slider, tt.elapsed)
+
+		if math.Abs(intensity-tt.intensity) > glowTolerance ||
+			math.Abs(pulse-tt.pulse) > glowTolerance ||
+			math.Abs(shimmer-tt.shimmer) > glowTolerance {
+			t.Errorf("%s at %v: got (%v, %v, %v), want (%v, %v, %v)", tt.anim, tt.elapsed,
+				intensity, pulse, shimmer, tt.intensity, tt.pulse, tt.shimmer)
+		}
+	}
+}
+
+func TestRegisterAnimation(t *testing.T) {
+	constant := AnimatorFunc(func(elapsed float64, colors NeonColors) AnimationFrame {
+		return AnimationFrame{Intensity: colors.MinIntensity, Pulse: 0.1, Shimmer: 0.05}
+	})
+	anim := RegisterAnimation("Тестовая", constant)
+
+	if anim.String() != "Тестовая" {
+		t.Errorf("String: got %q, want %q", anim.String(), "Тестовая")
+	}
+	if AnimatorFor(anim) == nil {
+		t.Fatal("AnimatorFor returned nil for a registered type")
+	}
+	if !slices.Contains(AnimationTypes(), anim) {
+		t.Error("AnimationTypes does not list the registered type")
+	}
+
+	slider := NewWithSettings(0, 100, GreenCyber, DragFullTrack, anim)
+	intensity, pulse, shimmer := glowAt(slider, 2*time.Second)
+	if intensity != GreenCyber.MinIntensity || pulse != 0.1 || shimmer != 0.05 {
+		t.Errorf("registered animation: got (%v, %v, %v), want (%v, 0.1, 0.05)",
+			intensity, pulse, shimmer, GreenCyber.MinIntensity)
+	}
+}
+
+func TestUnknownAnimationFallsBackToWave(t *testing.T) {
+	unknown := AnimationType(len(AnimationTypes()) + 100)
+	if AnimatorFor(unknown) != nil {
+		t.Error("AnimatorFor returned an animator for an unknown type")
+	}
+
+	got := NewWithSettings(0, 100, GreenCyber, DragFullTrack, unknown)
+	want := NewWithSettings(0, 100, GreenCyber, DragFullTrack, AnimationWave)
+	gotIntensity, _, _ := glowAt(got, time.Second)
+	wantIntensity, _, _ := glowAt(want, time.Second)
+	if gotIntensity != wantIntensity {
+		t.Errorf("unknown type intensity: got %v, want wave %v", gotIntensity, wantIntensity)
+	}
+}
@@ -0,0 +1,56 @@
+package neonslider
+
+import (
+	"sync"
+	"time"
+)
+
+// Clock - источник времени для анимации слайдера.
+// Позволяет подменить системное время и воспроизводить кадры детерминированно.
+type Clock interface {
+	Now() time.Time
+}
+
+// systemClock возвращает реальное системное время
+type systemClock struct{}
+
+func (systemClock) Now() time.Time {
+	return time.Now()
+}
+
+// SystemClock - часы по умолчанию, использующие time.Now
+var SystemClock Clock = systemClock{}
+
+// ManualClock - часы, время которых меняется только вручную.
+// Удобны в тестах: время сдвигается через Advance, после чего
+// кадр анимации рассчитывается вызовом NeonSlider.StepAnimation.
+type ManualClock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+// NewManualClock создаёт ручные часы, показывающие время start
+func NewManualClock(start time.Time) *ManualClock {
+	return &ManualClock{now: start}
+}
+
+// Now возвращает текущее время ручных часов
+func (c *ManualClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+// Advance сдвигает время вперёд на d
+func (c *ManualClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+}
+
+// Set устанавливает время часов
+func (c *ManualClock) Set(t time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = t
+}
@@ -0,0 +1,148 @@
+package neonslider
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/dialog"
+	"fyne.io/fyne/v2/widget"
+)
+
+// SecondaryTapped показывает контекстное меню со сбросом, копированием,
+// вставкой и точным вводом значения
+func (n *NeonSlider) SecondaryTapped(e *fyne.PointEvent) {
+	if n.Disabled() {
+		return
+	}
+	app := fyne.CurrentApp()
+	if app == nil {
+		return
+	}
+	c := app.Driver().CanvasForObject(n)
+	if c == nil {
+		return
+	}
+
+	reset := fyne.NewMenuItem("Reset to default", n.userReset)
+	copyValue := fyne.NewMenuItem("Copy value", n.copyValue)
+	paste := fyne.NewMenuItem("Paste value", n.pasteValue)
+	enter := fyne.NewMenuItem("Enter value…", func() { n.showEntryDialog(c) })
+
+	// Только для чтения: значение можно лишь скопировать
+	reset.Disabled = n.ReadOnly
+	enter.Disabled = n.ReadOnly
+	_, err := n.parseValue(app.Clipboard().Content())
+	paste.Disabled = n.ReadOnly || err != nil
+
+	menu := fyne.NewMenu("", reset, copyValue, paste, fyne.NewMenuItemSeparator(), enter)
+	widget.ShowPopUpMenuAtRelativePosition(menu, c, e.Position, n)
+}
+
+// userReset сбрасывает значение по действию пользователя
+func (n *NeonSlider) userReset() {
+	before := n.Value
+	n.Reset()
+	if n.Value != before {
+		n.pendingChange = true
+	}
+	n.fireChangeEnded()
+}
+
+// copyValue копирует текущее значение в буфер обмена
+func (n *NeonSlider) copyValue() {
+	fyne.CurrentApp().Clipboard().SetContent(n.FormatValue(n.Value))
+}
+
+// pasteValue устанавливает значение из буфера обмена с учетом диапазона и шага
+func (n *NeonSlider) pasteValue() {
+	value, err := n.parseValue(fyne.CurrentApp().Clipboard().Content())
+	if err != nil {
+		return
+	}
+	n.changeValue(value)
+	n.fireChangeEnded()
+}
+
+// showEntryDialog открывает диалог точного ввода значения в окне холста c
+func (n *NeonSlider) showEntryDialog(c fyne.Canvas) {
+	window := windowForCanvas(c)
+	if window == nil {
+		return
+	}
+
+	entry := widget.NewEntry()
+	entry.SetText(n.FormatValue(n.Value))
+	entry.Validator = n.validateText
+
+	items := []*widget.FormItem{
+		widget.NewFormItem("Value", entry),
+	}
+	form := dialog.NewForm("Enter value", "OK", "Cancel", items, func(confirmed bool) {
+		if !confirmed {
+			return
+		}
+		value, err := n.parseValue(entry.Text)
+		if err != nil {
+			return
+		}
+		n.changeValue(value)
+		n.fireChangeEnded()
+	}, window)
+	form.Show()
+	window.Canvas().Focus(entry)
+}
+
+// parseValue разбирает введенный текст через Formatter слайдера
+func (n *NeonSlider) parseValue(text string) (float64, error) {
+	return n.formatter().Parse(text)
+}
+
+// validateText проверяет, что текст - число в диапазоне слайдера, кратное шагу
+func (n *NeonSlider) validateText(text string) error {
+	value, err := n.parseValue(text)
+	if err != nil {
+		return err
+	}
+	return n.validateValue(value)
+}
+
+// validateValue проверяет значение на попадание в диапазон и сетку шага или в список остановок.
+// Точки привязки допустимы и вне сетки шага.
+func (n *NeonSlider) validateValue(value float64) error {
+	if math.IsNaN(value) {
+		return errors.New("value is not a number")
+	}
+	if value < n.Min || value > n.Max {
+		return fmt.Errorf("value must be between %s and %s",
+			n.FormatValue(n.Min), n.FormatValue(n.Max))
+	}
+	if len(n.Stops) > 0 {
+		if n.snap(value) != value {
+			return errors.New("value must be one of the allowed stops")
+		}
+		return nil
+	}
+	if n.Step > 0 && !n.isSnapPoint(value) {
+		// Допуск на ошибку округления для дробных шагов вроде 0.1
+		tolerance := n.Step * 1e-9
+		scale := scaleFor(n.Scale, n.Min, n.Max)
+		snapped := snapValue(scale, value, n.Min, n.Max, n.Step)
+		if math.Abs(scale.ToScale(snapped)-scale.ToScale(value)) > tolerance {
+			return fmt.Errorf("value must be a multiple of %s from %s",
+				n.FormatValue(n.Step), n.FormatValue(n.Min))
+		}
+	}
+	return nil
+}
+
+// windowForCanvas находит окно, которому принадлежит холст
+func windowForCanvas(c fyne.Canvas) fyne.Window {
+	for _, window := range fyne.CurrentApp().Driver().AllWindows() {
+		if window.Canvas() == c {
+			return window
+		}
+	}
+	return nil
+}
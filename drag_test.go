@@ -0,0 +1,122 @@
+package neonslider
+
+import (
+	"testing"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/test"
+)
+
+// newDragSlider создает слайдер 200x40, начавший перетаскивание от значения 50
+func newDragSlider(t *testing.T) (slider *NeonSlider, ended, changeEnded *int) {
+	t.Helper()
+	test.NewApp()
+
+	slider = NewWithStep(0, 100, 1)
+	slider.SetValue(50)
+	slider.Resize(fyne.NewSize(200, 40))
+	renderSlider(t, slider)
+
+	ended, changeEnded = new(int), new(int)
+	slider.OnDragEnd = func(float64) { *ended++ }
+	slider.OnChangeEnded = func(float64) { *changeEnded++ }
+
+	center := slider.thumbCenter
+	slider.Dragged(&fyne.DragEvent{
+		PointEvent: fyne.PointEvent{Position: center.Add(fyne.NewPos(20, 0))},
+		Dragged:    fyne.NewDelta(20, 0),
+	})
+	if !slider.isDragging {
+		t.Fatal("drag did not start")
+	}
+	return slider, ended, changeEnded
+}
+
+func TestInteractionOffEndsDrag(t *testing.T) {
+	tests := []struct {
+		name string
+		off  func(*NeonSlider)
+	}{
+		{"Disable", (*NeonSlider).Disable},
+		{"SetReadOnly", func(n *NeonSlider) { n.SetReadOnly(true) }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			slider, ended, changeEnded := newDragSlider(t)
+			tt.off(slider)
+
+			if slider.isDragging || slider.pendingChange {
+				t.Errorf("drag left open: isDragging=%v pendingChange=%v", slider.isDragging, slider.pendingChange)
+			}
+			if *ended != 1 || *changeEnded != 1 {
+				t.Errorf("callbacks: OnDragEnd=%d OnChangeEnded=%d, want 1 and 1", *ended, *changeEnded)
+			}
+
+			// Поздний DragEnd от драйвера не вызывает колбэки повторно
+			slider.DragEnd()
+			if *ended != 1 || *changeEnded != 1 {
+				t.Errorf("after DragEnd: OnDragEnd=%d OnChangeEnded=%d, want 1 and 1", *ended, *changeEnded)
+			}
+		})
+	}
+}
+
+func TestRelativeDragFromTrackFollowsPointer(t *testing.T) {
+	test.NewApp()
+	slider := NewWithStep(0, 100, 1)
+	slider.SetValue(50)
+	slider.Resize(fyne.NewSize(200, 40))
+	renderSlider(t, slider)
+
+	start := fyne.NewPos(30, slider.thumbCenter.Y)
+	if slider.isPointInThumb(start) {
+		t.Fatal("start point must be on the track, not on the thumb")
+	}
+	end := start.Add(fyne.NewPos(10, 0))
+	slider.Dragged(&fyne.DragEvent{
+		PointEvent: fyne.PointEvent{Position: end},
+		Dragged:    fyne.NewDelta(10, 0),
+	})
+
+	want, _ := slider.valueAt(end)
+	if want = slider.snap(want); slider.Value != want {
+		t.Errorf("value: got %v, want %v under the pointer", slider.Value, want)
+	}
+}
+
+func TestEscapeCancelsDrag(t *testing.T) {
+	slider, ended, changeEnded := newDragSlider(t)
+	if slider.Value == 50 {
+		t.Fatal("drag did not change the value")
+	}
+
+	slider.TypedKey(&fyne.KeyEvent{Name: fyne.KeyEscape})
+	if slider.Value != 50 || slider.isDragging {
+		t.Errorf("after Escape: value %v, isDragging %v; want 50 and false", slider.Value, slider.isDragging)
+	}
+
+	// Остаток жеста до отпускания кнопки игнорируется
+	slider.Dragged(&fyne.DragEvent{
+		PointEvent: fyne.PointEvent{Position: slider.thumbCenter.Add(fyne.NewPos(40, 0))},
+		Dragged:    fyne.NewDelta(40, 0),
+	})
+	slider.DragEnd()
+	if slider.Value != 50 {
+		t.Errorf("drag after Escape moved the value to %v", slider.Value)
+	}
+	if *ended != 1 || *changeEnded != 0 {
+		t.Errorf("callbacks: OnDragEnd=%d OnChangeEnded=%d, want 1 and 0", *ended, *changeEnded)
+	}
+
+	// Следующий жест снова перетаскивает
+	slider.Dragged(&fyne.DragEvent{
+		PointEvent: fyne.PointEvent{Position: slider.thumbCenter.Add(fyne.NewPos(20, 0))},
+		Dragged:    fyne.NewDelta(20, 0),
+	})
+	slider.DragEnd()
+	if slider.Value == 50 || *ended != 2 || *changeEnded != 1 {
+		t.Errorf("next drag: value %v, OnDragEnd=%d OnChangeEnded=%d; want a new value, 2 and 1",
+			slider.Value, *ended, *changeEnded)
+	}
+}
@@ -37,7 +37,10 @@ func createFullDemo() *fyne.Container {
 	// === SECTION 3: Step sliders ===
 	stepSection := createStepDemoSection()
 
-	// === SECTION 4: Interactive customization ===
+	// === SECTION 4: Range sliders ===
+	rangeSection := createRangeDemoSection()
+
+	// === SECTION 5: Interactive customization ===
 	customSection := createCustomizationSection()
 
 	return container.NewVBox(
@@ -52,6 +55,8 @@ func createFullDemo() *fyne.Container {
 		widget.NewSeparator(),
 		stepSection,
 		widget.NewSeparator(),
+		rangeSection,
+		widget.NewSeparator(),
 		customSection,
 	)
 }
@@ -77,21 +82,13 @@ func createBasicColorsSection() *widget.Card {
 	tealSlider := neonslider.NewWithColor(0, 100, neonslider.TealWave)
 	tealSlider.SetValue(75)
 
-	// Value labels
-	greenLabel := widget.NewLabelWithStyle("25%", fyne.TextAlignCenter, fyne.TextStyle{Bold: true})
-	blueLabel := widget.NewLabelWithStyle("45%", fyne.TextAlignCenter, fyne.TextStyle{Bold: true})
-	pinkLabel := widget.NewLabelWithStyle("65%", fyne.TextAlignCenter, fyne.TextStyle{Bold: true})
-	orangeLabel := widget.NewLabelWithStyle("85%", fyne.TextAlignCenter, fyne.TextStyle{Bold: true})
-	purpleLabel := widget.NewLabelWithStyle("35%", fyne.TextAlignCenter, fyne.TextStyle{Bold: true})
-	tealLabel := widget.NewLabelWithStyle("75%", fyne.TextAlignCenter, fyne.TextStyle{Bold: true})
-
-	// Bind handlers
-	greenSlider.OnChanged = func(v float64) { greenLabel.SetText(fmt.Sprintf("%.1f%%", v)) }
-	blueSlider.OnChanged = func(v float64) { blueLabel.SetText(fmt.Sprintf("%.1f%%", v)) }
-	pinkSlider.OnChanged = func(v float64) { pinkLabel.SetText(fmt.Sprintf("%.1f%%", v)) }
-	orangeSlider.OnChanged = func(v float64) { orangeLabel.SetText(fmt.Sprintf("%.1f%%", v)) }
-	purpleSlider.OnChanged = func(v float64) { purpleLabel.SetText(fmt.Sprintf("%.1f%%", v)) }
-	tealSlider.OnChanged = func(v float64) { tealLabel.SetText(fmt.Sprintf("%.1f%%", v)) }
+	// Values float above the thumb on hover and while dragging
+	for _, slider := range []*neonslider.NeonSlider{
+		greenSlider, blueSlider, pinkSlider, orangeSlider, purpleSlider, tealSlider,
+	} {
+		slider.SetFormatter(neonslider.PercentFormatter{Decimals: 1})
+		slider.SetTooltip(neonslider.TooltipOnInteraction)
+	}
 
 	content := container.NewVBox(
 		widget.NewRichTextFromMarkdown("### 🎨 All Color Schemes"),
@@ -101,38 +98,38 @@ func createBasicColorsSection() *widget.Card {
 			container.NewVBox(
 				widget.NewLabelWithStyle("🟢 Cyber Green", fyne.TextAlignLeading, fyne.TextStyle{Bold: true}),
 				widget.NewLabel("Stable bright glow | Full area"),
-				greenLabel, greenSlider,
+				greenSlider,
 
 				widget.NewSeparator(),
 
 				widget.NewLabelWithStyle("🟣 Pink Cyber", fyne.TextAlignLeading, fyne.TextStyle{Bold: true}),
 				widget.NewLabel("Energetic animation | Full area"),
-				pinkLabel, pinkSlider,
+				pinkSlider,
 
 				widget.NewSeparator(),
 
 				widget.NewLabelWithStyle("💜 Purple Dream", fyne.TextAlignLeading, fyne.TextStyle{Bold: true}),
 				widget.NewLabel("Mystical glow | Full area"),
-				purpleLabel, purpleSlider,
+				purpleSlider,
 			),
 
 			// Right column
 			container.NewVBox(
 				widget.NewLabelWithStyle("🔵 Electric Blue", fyne.TextAlignLeading, fyne.TextStyle{Bold: true}),
 				widget.NewLabel("Smooth animation | Thumb only"),
-				blueLabel, blueSlider,
+				blueSlider,
 
 				widget.NewSeparator(),
 
 				widget.NewLabelWithStyle("🟠 Orange Fire", fyne.TextAlignLeading, fyne.TextStyle{Bold: true}),
 				widget.NewLabel("Powerful glow | Full area"),
-				orangeLabel, orangeSlider,
+				orangeSlider,
 
 				widget.NewSeparator(),
 
 				widget.NewLabelWithStyle("💎 Teal Wave", fyne.TextAlignLeading, fyne.TextStyle{Bold: true}),
 				widget.NewLabel("Smooth waves | Full area"),
-				tealLabel, tealSlider,
+				tealSlider,
 			),
 		),
 	)
@@ -155,15 +152,11 @@ func createAnimationDemoSection() *widget.Card {
 		neonslider.DragFullTrack, neonslider.AnimationBreathing)
 	breathSlider.SetValue(80)
 
-	// Labels
-	waveLabel := widget.NewLabelWithStyle("40%", fyne.TextAlignCenter, fyne.TextStyle{Bold: true})
-	pulseLabel := widget.NewLabelWithStyle("60%", fyne.TextAlignCenter, fyne.TextStyle{Bold: true})
-	breathLabel := widget.NewLabelWithStyle("80%", fyne.TextAlignCenter, fyne.TextStyle{Bold: true})
-
-	// Handlers
-	waveSlider.OnChanged = func(v float64) { waveLabel.SetText(fmt.Sprintf("%.1f%%", v)) }
-	pulseSlider.OnChanged = func(v float64) { pulseLabel.SetText(fmt.Sprintf("%.1f%%", v)) }
-	breathSlider.OnChanged = func(v float64) { breathLabel.SetText(fmt.Sprintf("%.1f%%", v)) }
+	// Values are always shown above the thumb
+	for _, slider := range []*neonslider.NeonSlider{waveSlider, pulseSlider, breathSlider} {
+		slider.SetFormatter(neonslider.PercentFormatter{Decimals: 1})
+		slider.SetTooltip(neonslider.TooltipAlways)
+	}
 
 	content := container.NewVBox(
 		widget.NewRichTextFromMarkdown("### 🎭 Animation Types"),
@@ -172,7 +165,7 @@ func createAnimationDemoSection() *widget.Card {
 		container.NewVBox(
 			widget.NewLabelWithStyle("🌊 Wave Animation", fyne.TextAlignLeading, fyne.TextStyle{Bold: true}),
 			widget.NewLabel("Multi-layer waves with fast shimmer | Purple Dream"),
-			waveLabel, waveSlider,
+			waveSlider,
 		),
 
 		widget.NewSeparator(),
@@ -180,7 +173,7 @@ func createAnimationDemoSection() *widget.Card {
 		container.NewVBox(
 			widget.NewLabelWithStyle("💓 Pulse Animation", fyne.TextAlignLeading, fyne.TextStyle{Bold: true}),
 			widget.NewLabel("Rhythmic oscillations with sharp bursts | Teal Wave"),
-			pulseLabel, pulseSlider,
+			pulseSlider,
 		),
 
 		widget.NewSeparator(),
@@ -188,7 +181,7 @@ func createAnimationDemoSection() *widget.Card {
 		container.NewVBox(
 			widget.NewLabelWithStyle("🫁 Breathing", fyne.TextAlignLeading, fyne.TextStyle{Bold: true}),
 			widget.NewLabel("Slow smooth transitions + peak shimmer | Electric Blue"),
-			breathLabel, breathSlider,
+			breathSlider,
 		),
 	)
 
@@ -216,6 +209,8 @@ func createStepDemoSection() *widget.Card {
 	step10Slider.SetColors(neonslider.OrangeFire)
 	step10Slider.SetAnimationType(neonslider.AnimationBreathing)
 	step10Slider.SetValue(37) // Will be rounded to 40
+	step10Slider.SetTickPlacement(neonslider.TicksBelow)
+	step10Slider.SetTickLabels(true)
 
 	// Labels
 	noStepLabel := widget.NewLabelWithStyle("33.7", fyne.TextAlignCenter, fyne.TextStyle{Bold: true})
@@ -223,11 +218,42 @@ func createStepDemoSection() *widget.Card {
 	step5Label := widget.NewLabelWithStyle("25", fyne.TextAlignCenter, fyne.TextStyle{Bold: true})
 	step10Label := widget.NewLabelWithStyle("40", fyne.TextAlignCenter, fyne.TextStyle{Bold: true})
 
-	// Handlers
-	noStepSlider.OnChanged = func(v float64) { noStepLabel.SetText(fmt.Sprintf("%.2f", v)) }
-	step1Slider.OnChanged = func(v float64) { step1Label.SetText(fmt.Sprintf("%.0f", v)) }
-	step5Slider.OnChanged = func(v float64) { step5Label.SetText(fmt.Sprintf("%.0f", v)) }
-	step10Slider.OnChanged = func(v float64) { step10Label.SetText(fmt.Sprintf("%.0f", v)) }
+	// Handlers (decimals follow the step)
+	// Soft detents at the quarter marks on the continuous slider
+	noStepSlider.SetSnapPoints(10, 25, 50, 75)
+
+	noStepSlider.OnChanged = func(v float64) { noStepLabel.SetText(noStepSlider.FormatValue(v)) }
+	step1Slider.OnChanged = func(v float64) { step1Label.SetText(step1Slider.FormatValue(v)) }
+	step5Slider.OnChanged = func(v float64) { step5Label.SetText(step5Slider.FormatValue(v)) }
+	step10Slider.OnChanged = func(v float64) { step10Label.SetText(step10Slider.FormatValue(v)) }
+
+	// Logarithmic frequency slider: every decade gets the same track length
+	freqSlider := neonslider.NewWithColor(20, 20000, neonslider.TealWave)
+	freqSlider.SetScale(neonslider.LogScale{})
+	freqSlider.SetFormatter(neonslider.UnitFormatter{Unit: "Hz", Decimals: 1})
+	freqSlider.SetValue(1000)
+	freqSlider.SetTicks([]float64{20, 100, 1000, 10000, 20000}, []float64{50, 200, 500, 2000, 5000})
+	freqSlider.SetTickPlacement(neonslider.TicksAbove)
+	freqSlider.SetTickLabels(true)
+	freqLabel := widget.NewLabelWithStyle(freqSlider.ValueText(), fyne.TextAlignCenter, fyne.TextStyle{Bold: true})
+	freqSlider.OnChanged = func(v float64) { freqLabel.SetText(freqSlider.FormatValue(v)) }
+
+	// Discrete stops: arbitrary values and labelled categories
+	zoomSlider := neonslider.NewWithValues(1, 2, 5, 10, 20, 50)
+	zoomSlider.SetColors(neonslider.PurpleDream)
+	zoomSlider.SetTickPlacement(neonslider.TicksBelow)
+	zoomSlider.SetTickLabels(true)
+	zoomSlider.SetTooltip(neonslider.TooltipOnInteraction)
+
+	qualitySlider := neonslider.NewWithLabels("Low", "Medium", "High", "Ultra")
+	qualitySlider.SetColors(neonslider.OrangeFire)
+	qualitySlider.SetTickPlacement(neonslider.TicksBelow)
+	qualitySlider.SetTickLabels(true)
+	qualityLabel := widget.NewLabelWithStyle("Quality: "+qualitySlider.SelectedLabel(),
+		fyne.TextAlignCenter, fyne.TextStyle{Bold: true})
+	qualitySlider.OnSelected = func(index int, label string) {
+		qualityLabel.SetText(fmt.Sprintf("Quality: %s (#%d)", label, index))
+	}
 
 	content := container.NewVBox(
 		widget.NewRichTextFromMarkdown("### 📏 Step Demo"),
@@ -237,7 +263,7 @@ func createStepDemoSection() *widget.Card {
 			// Left column
 			container.NewVBox(
 				widget.NewLabelWithStyle("🎯 No limits (Step: 0)", fyne.TextAlignLeading, fyne.TextStyle{Bold: true}),
-				widget.NewLabel("Any decimal values | Green"),
+				widget.NewLabel("Any decimal values, detents at 25/50/75 | Green"),
 				noStepLabel, noStepSlider,
 
 				widget.NewSeparator(),
@@ -260,18 +286,82 @@ func createStepDemoSection() *widget.Card {
 				step10Label, step10Slider,
 			),
 		),
+
+		widget.NewSeparator(),
+		widget.NewLabelWithStyle("🔊 Frequency (log scale)", fyne.TextAlignLeading, fyne.TextStyle{Bold: true}),
+		widget.NewLabel("20 Hz - 20 kHz | Equal space per decade"),
+		freqLabel, freqSlider,
+
+		widget.NewSeparator(),
+		container.NewGridWithColumns(2,
+			container.NewVBox(
+				widget.NewLabelWithStyle("🔍 Zoom stops", fyne.TextAlignLeading, fyne.TextStyle{Bold: true}),
+				widget.NewLabel("1, 2, 5, 10, 20, 50 | Evenly spaced"),
+				zoomSlider,
+			),
+			container.NewVBox(
+				widget.NewLabelWithStyle("🎚 Labelled stops", fyne.TextAlignLeading, fyne.TextStyle{Bold: true}),
+				widget.NewLabel("Arrow keys jump between stops"),
+				qualityLabel, qualitySlider,
+			),
+		),
 	)
 
 	return widget.NewCard("🎯 Step Control", "Discrete steps for precise value control", content)
 }
 
+// Demo of range sliders with two thumbs
+func createRangeDemoSection() *widget.Card {
+	priceSlider := neonslider.NewRangeWithSettings(0, 1000, 10, neonslider.OrangeFire,
+		neonslider.DragFullTrack, neonslider.AnimationWave)
+	priceSlider.SetMinGap(50)
+	priceSlider.SetRange(200, 600)
+
+	timeSlider := neonslider.NewRangeWithSettings(0, 24, 0.5, neonslider.TealWave,
+		neonslider.DragThumbOnly, neonslider.AnimationBreathing)
+	timeSlider.SetRange(9, 18)
+
+	priceLabel := widget.NewLabelWithStyle("$200 - $600", fyne.TextAlignCenter, fyne.TextStyle{Bold: true})
+	timeLabel := widget.NewLabelWithStyle("09:00 - 18:00", fyne.TextAlignCenter, fyne.TextStyle{Bold: true})
+
+	priceSlider.OnChanged = func(low, high float64) {
+		priceLabel.SetText(fmt.Sprintf("$%.0f - $%.0f", low, high))
+	}
+	timeSlider.OnChanged = func(low, high float64) {
+		timeLabel.SetText(fmt.Sprintf("%02d:%02d - %02d:%02d",
+			int(low), int(low*60)%60, int(high), int(high*60)%60))
+	}
+
+	content := container.NewVBox(
+		widget.NewRichTextFromMarkdown("### ↔️ Range Selection"),
+		widget.NewLabel("Two thumbs select an interval"),
+
+		container.NewGridWithColumns(2,
+			container.NewVBox(
+				widget.NewLabelWithStyle("💰 Price range", fyne.TextAlignLeading, fyne.TextStyle{Bold: true}),
+				widget.NewLabel("Step 10, minimum gap 50 | Full area"),
+				priceLabel, priceSlider,
+			),
+			container.NewVBox(
+				widget.NewLabelWithStyle("🕘 Time window", fyne.TextAlignLeading, fyne.TextStyle{Bold: true}),
+				widget.NewLabel("Half-hour steps | Thumbs only"),
+				timeLabel, timeSlider,
+			),
+		),
+	)
+
+	return widget.NewCard("📐 Range Sliders", "Select intervals with two neon thumbs", content)
+}
+
 // Interactive customization section
 func createCustomizationSection() *widget.Card {
 	// Create customizable slider
 	customSlider := neonslider.NewWithStep(0, 100, 0)
 	customSlider.SetValue(50)
 
-	valueLabel := widget.NewLabelWithStyle("50.0", fyne.TextAlignCenter, fyne.TextStyle{Bold: true})
+	// The companion entry shows the value and accepts typed input
+	customControl := neonslider.NewSliderWithEntry(customSlider)
+
 	infoLabel := widget.NewLabelWithStyle("Step: 0 | Color: Green | Animation: Wave",
 		fyne.TextAlignCenter, fyne.TextStyle{})
 
@@ -320,14 +410,6 @@ func createCustomizationSection() *widget.Card {
 			stepText, colorText, animText))
 	}
 
-	customSlider.OnChanged = func(v float64) {
-		if customSlider.GetStep() > 0 {
-			valueLabel.SetText(fmt.Sprintf("%.0f", v))
-		} else {
-			valueLabel.SetText(fmt.Sprintf("%.2f", v))
-		}
-	}
-
 	// Color scheme selector
 	colorSelect = widget.NewSelect([]string{
 		"🟢 Cyber Green",
@@ -467,8 +549,7 @@ func createCustomizationSection() *widget.Card {
 		widget.NewSeparator(),
 
 		infoLabel,
-		valueLabel,
-		customSlider,
+		customControl,
 
 		widget.NewLabel("💡 Change settings above and see how the slider changes"),
 	)
@@ -0,0 +1,236 @@
+package neonslider
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ValueFormatter переводит значение слайдера в текст и обратно.
+// Используется для копирования и вставки, точного ввода, поля ввода
+// NeonSliderWithEntry и подписей на слайдере.
+type ValueFormatter interface {
+	Format(value float64) string
+	Parse(text string) (float64, error)
+}
+
+// maxStepDecimals - наибольшее число знаков после запятой, выводимое из шага
+const maxStepDecimals = 6
+
+// DecimalFormatter выводит число с фиксированным количеством знаков после запятой
+type DecimalFormatter struct {
+	Decimals int // Количество знаков после запятой
+}
+
+// Format выводит значение с Decimals знаками после запятой
+func (f DecimalFormatter) Format(value float64) string {
+	return strconv.FormatFloat(value, 'f', f.Decimals, 64)
+}
+
+// Parse разбирает число; допускается десятичная запятая
+func (f DecimalFormatter) Parse(text string) (float64, error) {
+	return parseNumber(text)
+}
+
+// StepFormatter возвращает форматирование с количеством знаков, достаточным для шага:
+// шаг 1 дает целые числа, 0.5 - один знак, 0.25 - два. Без шага выводятся два знака.
+func StepFormatter(step float64) DecimalFormatter {
+	return DecimalFormatter{Decimals: decimalsForStep(step)}
+}
+
+// decimalsForStep считает знаки после запятой в записи шага
+func decimalsForStep(step float64) int {
+	if step <= 0 {
+		return 2
+	}
+	return decimalPlaces(step)
+}
+
+// decimalPlaces считает знаки после запятой в кратчайшей записи числа
+func decimalPlaces(value float64) int {
+	text := strconv.FormatFloat(value, 'f', -1, 64)
+	dot := strings.IndexByte(text, '.')
+	if dot < 0 {
+		return 0
+	}
+	return min(len(text)-dot-1, maxStepDecimals)
+}
+
+// PercentFormatter выводит значение в процентах
+type PercentFormatter struct {
+	Decimals int  // Количество знаков после запятой
+	Ratio    bool // Значение - доля (0.0-1.0) и умножается на 100
+}
+
+// Format выводит значение со знаком процента
+func (f PercentFormatter) Format(value float64) string {
+	if f.Ratio {
+		value *= 100
+	}
+	return strconv.FormatFloat(value, 'f', f.Decimals, 64) + "%"
+}
+
+// Parse разбирает число с необязательным знаком процента
+func (f PercentFormatter) Parse(text string) (float64, error) {
+	value, err := parseNumber(strings.TrimSuffix(strings.TrimSpace(text), "%"))
+	if err != nil {
+		return 0, err
+	}
+	if f.Ratio {
+		value /= 100
+	}
+	return value, nil
+}
+
+// siPrefixes - приставки СИ от меньших к большим
+var siPrefixes = []struct {
+	symbol string
+	factor float64
+}{
+	{"p", 1e-12}, {"n", 1e-9}, {"µ", 1e-6}, {"m", 1e-3}, {"", 1},
+	{"k", 1e3}, {"M", 1e6}, {"G", 1e9}, {"T", 1e12},
+}
+
+// UnitFormatter выводит значение с единицей измерения и приставкой СИ: 20000 Гц - "20 kHz"
+type UnitFormatter struct {
+	Unit     string // Единица измерения, например "Hz" или "s"
+	Decimals int    // Количество знаков после запятой у мантиссы
+}
+
+// Format выбирает приставку так, чтобы мантисса была в диапазоне [1, 1000)
+func (f UnitFormatter) Format(value float64) string {
+	prefix := siPrefixes[4] // Без приставки
+	magnitude := math.Abs(value)
+	if magnitude != 0 && !math.IsInf(magnitude, 0) && !math.IsNaN(magnitude) {
+		for _, p := range siPrefixes {
+			if magnitude >= p.factor {
+				prefix = p
+			}
+		}
+		if magnitude < siPrefixes[0].factor {
+			prefix = siPrefixes[0]
+		}
+	}
+
+	number := strconv.FormatFloat(value/prefix.factor, 'f', f.Decimals, 64)
+	return strings.TrimSpace(number + " " + prefix.symbol + f.Unit)
+}
+
+// Parse разбирает число с необязательными приставкой и единицей: "20k", "20 kHz", "1.5 ms"
+func (f UnitFormatter) Parse(text string) (float64, error) {
+	text = strings.TrimSpace(text)
+	text = strings.TrimSpace(strings.TrimSuffix(text, f.Unit))
+
+	factor := 1.0
+	for _, p := range siPrefixes {
+		if p.symbol != "" && strings.HasSuffix(text, p.symbol) {
+			factor = p.factor
+			text = strings.TrimSuffix(text, p.symbol)
+			break
+		}
+	}
+	// Распространенная замена "µ" при вводе с клавиатуры
+	if factor == 1 && strings.HasSuffix(text, "u") {
+		factor = 1e-6
+		text = strings.TrimSuffix(text, "u")
+	}
+
+	value, err := parseNumber(text)
+	if err != nil {
+		return 0, err
+	}
+	return value * factor, nil
+}
+
+// DurationFormatter выводит значение как time.Duration: 90 секунд - "1m30s"
+type DurationFormatter struct {
+	Unit time.Duration // Единица значения слайдера (0 = секунда)
+}
+
+// Format переводит значение в длительность
+func (f DurationFormatter) Format(value float64) string {
+	return time.Duration(value * float64(f.unit())).String()
+}
+
+// Parse разбирает длительность ("1m30s") или число в единицах Unit
+func (f DurationFormatter) Parse(text string) (float64, error) {
+	text = strings.TrimSpace(text)
+	if duration, err := time.ParseDuration(text); err == nil {
+		return float64(duration) / float64(f.unit()), nil
+	}
+	return parseNumber(text)
+}
+
+func (f DurationFormatter) unit() time.Duration {
+	if f.Unit <= 0 {
+		return time.Second
+	}
+	return f.Unit
+}
+
+// DecibelFormatter выводит значение в децибелах: "+3.0 dB", "-inf dB".
+// "-inf" выводится и принимается только для линейного усиления, где означает 0.
+type DecibelFormatter struct {
+	Decimals int  // Количество знаков после запятой
+	Linear   bool // Значение - линейное усиление, выводится как 20*log10(value)
+}
+
+// Format выводит значение в децибелах со знаком
+func (f DecibelFormatter) Format(value float64) string {
+	if f.Linear {
+		if value <= 0 {
+			return "-inf dB" // Усиление не бывает отрицательным, а 0 - полная тишина
+		}
+		value = 20 * math.Log10(value)
+	}
+	if math.IsInf(value, -1) {
+		return "-inf dB"
+	}
+	number := strconv.FormatFloat(value, 'f', f.Decimals, 64)
+	if value > 0 {
+		number = "+" + number
+	}
+	return number + " dB"
+}
+
+// Parse разбирает значение в децибелах с необязательным "dB"
+func (f DecibelFormatter) Parse(text string) (float64, error) {
+	text = strings.TrimSpace(text)
+	text = strings.TrimSpace(strings.TrimSuffix(strings.TrimSuffix(text, "dB"), "db"))
+
+	if strings.EqualFold(text, "-inf") {
+		if !f.Linear {
+			return 0, fmt.Errorf("%q is not a finite number", text)
+		}
+		return 0, nil
+	}
+
+	value, err := parseNumber(text)
+	if err != nil {
+		return 0, err
+	}
+	if f.Linear {
+		return math.Pow(10, value/20), nil
+	}
+	return value, nil
+}
+
+// parseNumber разбирает конечное число; допускается десятичная запятая и ведущий плюс.
+// NaN и бесконечности отклоняются.
+func parseNumber(text string) (float64, error) {
+	text = strings.ReplaceAll(strings.TrimSpace(text), ",", ".")
+	if text == "" {
+		return 0, errors.New("value is empty")
+	}
+	value, err := strconv.ParseFloat(text, 64)
+	if err != nil {
+		return 0, fmt.Errorf("%q is not a number", text)
+	}
+	if math.IsNaN(value) || math.IsInf(value, 0) {
+		return 0, fmt.Errorf("%q is not a finite number", text)
+	}
+	return value, nil
+}
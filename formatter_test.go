@@ -0,0 +1,57 @@
+package neonslider
+
+import (
+	"math"
+	"testing"
+)
+
+func TestParseRejectsNonFiniteNumbers(t *testing.T) {
+	formatters := []ValueFormatter{
+		DecimalFormatter{Decimals: 1},
+		PercentFormatter{},
+		UnitFormatter{Unit: "Hz"},
+		DecibelFormatter{},
+	}
+
+	for _, f := range formatters {
+		for _, text := range []string{"NaN", "nan", "Inf", "+Inf", "-inf", "-Infinity"} {
+			if value, err := f.Parse(text); err == nil {
+				t.Errorf("%T.Parse(%q) = %v, want error", f, text, value)
+			}
+		}
+	}
+}
+
+func TestDecibelMinusInfOnlyForLinearGain(t *testing.T) {
+	linear := DecibelFormatter{Linear: true}
+	for _, text := range []string{"-inf", "-inf dB", "-INF"} {
+		if value, err := linear.Parse(text); err != nil || value != 0 {
+			t.Errorf("linear Parse(%q) = %v, %v; want 0, nil", text, value, err)
+		}
+	}
+	if value, err := (DecibelFormatter{}).Parse("-inf"); err == nil {
+		t.Errorf("Parse(-inf) without Linear = %v, want error", value)
+	}
+
+	for _, value := range []float64{0, -0.5} {
+		if got := linear.Format(value); got != "-inf dB" {
+			t.Errorf("linear Format(%v) = %q, want %q", value, got, "-inf dB")
+		}
+	}
+	if got := linear.Format(1); got != "0 dB" {
+		t.Errorf("linear Format(1) = %q, want %q", got, "0 dB")
+	}
+}
+
+func TestSetValueIgnoresNaN(t *testing.T) {
+	slider := New(0, 100)
+	renderSlider(t, slider)
+	slider.SetValue(40)
+	slider.SetValue(math.NaN())
+	if slider.Value != 40 {
+		t.Errorf("value after NaN: got %v, want 40", slider.Value)
+	}
+	if err := slider.validateValue(math.NaN()); err == nil {
+		t.Error("validateValue accepted NaN")
+	}
+}
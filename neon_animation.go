@@ -0,0 +1,165 @@
+package neonslider
+
+import (
+	"math"
+	"time"
+
+	"fyne.io/fyne/v2"
+)
+
+// animatedWidget - виджет, кадры которого рассчитывает neonAnimation
+type animatedWidget interface {
+	animationTarget
+	StepAnimation()
+	redraw() // Перерисовка кадра без пересчета делений шкалы
+}
+
+// neonAnimation - общая неоновая анимация слайдеров: свечение, часы и жизненный цикл
+// в общем планировщике. Встраивается в NeonSlider и NeonRangeSlider.
+type neonAnimation struct {
+	AnimationType AnimationType // Тип анимации
+	Animator      Animator      // Пользовательская анимация, если задана - заменяет AnimationType
+
+	widget animatedWidget // Виджет, который регистрируется в планировщике
+
+	// Параметры анимации (внутренние)
+	glowIntensity  float64   // Текущая интенсивность свечения
+	pulsePhase     float64   // Фаза пульсации
+	shimmerPhase   float64   // Фаза мерцания
+	lastUpdateTime time.Time // Время последнего обновления
+	animStart      time.Time // Точка отсчёта анимации
+	clock          Clock     // Источник времени анимации
+
+	// Жизненный цикл анимации (внутренние)
+	animPaused bool // Флаг паузы: слайдер остаётся в планировщике, но кадры пропускаются
+}
+
+// newNeonAnimation создаёт анимацию виджета на системных часах с общей точкой отсчёта
+func newNeonAnimation(widget animatedWidget, animType AnimationType, colors NeonColors) neonAnimation {
+	return neonAnimation{
+		AnimationType:  animType,
+		widget:         widget,
+		glowIntensity:  colors.MinIntensity,
+		lastUpdateTime: SystemClock.Now(),
+		animStart:      scheduler.startTime,
+		clock:          SystemClock,
+	}
+}
+
+// SetAnimationType изменяет тип анимации
+func (a *neonAnimation) SetAnimationType(animType AnimationType) {
+	a.AnimationType = animType
+	a.Animator = nil
+}
+
+// SetAnimator задаёт пользовательскую анимацию без регистрации в реестре
+func (a *neonAnimation) SetAnimator(animator Animator) {
+	a.Animator = animator
+}
+
+// currentAnimator выбирает анимацию слайдера
+func (a *neonAnimation) currentAnimator() Animator {
+	return resolveAnimator(a.Animator, a.AnimationType)
+}
+
+// StartAnimation запускает анимацию слайдера.
+// Слайдер регистрируется в общем планировщике и обновляется до вызова
+// StopAnimation или уничтожения рендерера. Повторный вызов для уже
+// анимируемого слайдера ничего не меняет, в том числе не снимает паузу.
+func (a *neonAnimation) StartAnimation() {
+	scheduler.register(a.widget)
+}
+
+// StopAnimation останавливает анимацию и снимает слайдер с планировщика
+func (a *neonAnimation) StopAnimation() {
+	scheduler.unregister(a.widget)
+	a.animPaused = false
+}
+
+// PauseAnimation приостанавливает анимацию, сохраняя текущее свечение
+func (a *neonAnimation) PauseAnimation() {
+	a.animPaused = true
+}
+
+// ResumeAnimation возобновляет приостановленную анимацию
+func (a *neonAnimation) ResumeAnimation() {
+	a.animPaused = false
+}
+
+// IsAnimating сообщает, запущена ли анимация и не стоит ли она на паузе
+func (a *neonAnimation) IsAnimating() bool {
+	return scheduler.isRegistered(a.widget) && !a.animPaused
+}
+
+// SetClock задаёт источник времени анимации.
+// Отсчёт анимации начинается заново от текущего времени часов.
+func (a *neonAnimation) SetClock(clock Clock) {
+	if clock == nil {
+		clock = SystemClock
+	}
+	a.clock = clock
+	a.animStart = clock.Now()
+	a.lastUpdateTime = a.animStart
+}
+
+// Glow возвращает текущие интенсивность свечения, фазу пульсации и фазу мерцания
+func (a *neonAnimation) Glow() (intensity, pulse, shimmer float64) {
+	return a.glowIntensity, a.pulsePhase, a.shimmerPhase
+}
+
+// animateFrame обновляет свечение на очередном кадре общего планировщика
+func (a *neonAnimation) animateFrame() {
+	// Отключенный слайдер застывает в приглушенном состоянии и не тратит кадры
+	if d, ok := a.widget.(fyne.Disableable); a.animPaused || ok && d.Disabled() {
+		return
+	}
+	a.widget.StepAnimation()
+	a.widget.redraw()
+}
+
+// tick продвигает часы анимации и возвращает время с прошлого кадра в секундах
+func (a *neonAnimation) tick() (now time.Time, dt float64) {
+	now = a.clock.Now()
+	dt = now.Sub(a.lastUpdateTime).Seconds()
+	a.lastUpdateTime = now
+	return now, dt
+}
+
+// updateGlow рассчитывает свечение на момент now. Возвращает false,
+// если время некорректно и свечение не изменилось.
+func (a *neonAnimation) updateGlow(now time.Time, colors *NeonColors, dragging bool) bool {
+	// Сброс каждые 24 часа
+	elapsed := math.Mod(now.Sub(a.animStart).Seconds(), 86400)
+	if elapsed < 0 || math.IsNaN(elapsed) || math.IsInf(elapsed, 0) {
+		return false
+	}
+
+	frame := glowFrame(a.currentAnimator(), elapsed, colors, dragging)
+	a.glowIntensity = frame.Intensity
+	a.pulsePhase = frame.Pulse
+	a.shimmerPhase = frame.Shimmer
+	return true
+}
+
+// glowFrame рассчитывает кадр анимации с гарантией границ и усилением при перетаскивании
+func glowFrame(animator Animator, elapsed float64, colors *NeonColors, dragging bool) AnimationFrame {
+	frame := animator.Animate(elapsed, *colors)
+
+	// Гарантируем границы
+	frame.Intensity = math.Max(colors.MinIntensity,
+		math.Min(frame.Intensity, colors.MaxIntensity))
+
+	// УСИЛЕННЫЙ эффект при перетаскивании
+	if dragging {
+		dragBoost := (colors.MaxIntensity - colors.MinIntensity) * 0.3 // Увеличено
+		maxPossible := colors.MaxIntensity - frame.Intensity
+		if dragBoost > maxPossible {
+			dragBoost = maxPossible
+		}
+		frame.Intensity += dragBoost
+		frame.Pulse *= 1.5 // Усиливаем все эффекты
+		frame.Shimmer *= 1.5
+	}
+
+	return frame
+}
@@ -8,6 +8,7 @@ import (
 
 	"fyne.io/fyne/v2"
 	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/data/binding"
 	"fyne.io/fyne/v2/driver/desktop"
 	"fyne.io/fyne/v2/widget"
 )
@@ -34,6 +35,76 @@ func (mode SliderDragMode) String() string {
 	}
 }
 
+// DragBehavior определяет, как ползунок следует за указателем при перетаскивании
+type DragBehavior int
+
+const (
+	// DragRelative - ползунок сдвигается вместе с указателем, сохраняя точку захвата
+	DragRelative DragBehavior = iota
+	// DragJump - ползунок перескакивает под указатель в начале перетаскивания
+	DragJump
+)
+
+// String возвращает строковое представление поведения перетаскивания
+func (behavior DragBehavior) String() string {
+	switch behavior {
+	case DragRelative:
+		return "Относительное"
+	case DragJump:
+		return "Прыжок к указателю"
+	default:
+		return "Неизвестное поведение"
+	}
+}
+
+// Orientation определяет направление слайдера
+type Orientation int
+
+const (
+	// Horizontal - горизонтальный слайдер, значение растет слева направо
+	Horizontal Orientation = iota
+	// Vertical - вертикальный слайдер, значение растет снизу вверх
+	Vertical
+)
+
+// String возвращает строковое представление ориентации
+func (o Orientation) String() string {
+	switch o {
+	case Horizontal:
+		return "Горизонтальная"
+	case Vertical:
+		return "Вертикальная"
+	default:
+		return "Неизвестная ориентация"
+	}
+}
+
+// ScrollActivation определяет, когда слайдер реагирует на колесо мыши и трекпад
+type ScrollActivation int
+
+const (
+	// ScrollDisabled - прокрутка не меняет значение (по умолчанию)
+	ScrollDisabled ScrollActivation = iota
+	// ScrollOnHover - прокрутка меняет значение, пока указатель над слайдером
+	ScrollOnHover
+	// ScrollOnFocus - прокрутка меняет значение только у слайдера с фокусом клавиатуры
+	ScrollOnFocus
+)
+
+// String возвращает строковое представление режима прокрутки
+func (mode ScrollActivation) String() string {
+	switch mode {
+	case ScrollDisabled:
+		return "Отключена"
+	case ScrollOnHover:
+		return "При наведении"
+	case ScrollOnFocus:
+		return "При фокусе"
+	default:
+		return "Неизвестный режим"
+	}
+}
+
 // AnimationType определяет тип анимации слайдера
 type AnimationType int
 
@@ -45,16 +116,11 @@ const (
 
 // String возвращает строковое представление типа анимации
 func (anim AnimationType) String() string {
-	switch anim {
-	case AnimationWave:
-		return "Волновая"
-	case AnimationPulse:
-		return "Пульсация"
-	case AnimationBreathing:
-		return "Дыхание"
-	default:
+	entry, ok := lookupAnimation(anim)
+	if !ok {
 		return "Неизвестная"
 	}
+	return entry.name
 }
 
 // NeonColors определяет цветовую схему неонового слайдера
@@ -197,28 +263,220 @@ func roundToStep(value, min, max, step float64) float64 {
 	return result
 }
 
+// snapValue ограничивает значение диапазоном и округляет его к шагу, если шаг задан.
+// Шаг откладывается от min в пространстве шкалы scale (nil = линейная шкала).
+func snapValue(scale Scale, value, min, max, step float64) float64 {
+	// Ограничиваем значение диапазоном
+	if value < min {
+		value = min
+	}
+	if value > max {
+		value = max
+	}
+	if step <= 0 {
+		return value
+	}
+
+	scale = scaleFor(scale, min, max)
+	low, high := scaledRange(scale, min, max)
+	switch position := roundToStep(scale.ToScale(value), low, high, step); position {
+	case low:
+		return min
+	case high:
+		return max
+	default:
+		return scale.FromScale(position)
+	}
+}
+
+// valueRatio возвращает положение значения на треке (0.0-1.0) для шкалы scale
+func valueRatio(scale Scale, value, min, max float64) float64 {
+	scale = scaleFor(scale, min, max)
+	low, high := scaledRange(scale, min, max)
+	ratio := (scale.ToScale(value) - low) / (high - low)
+	if math.IsNaN(ratio) || math.IsInf(ratio, 0) {
+		return 0
+	}
+	return ratio
+}
+
+// trackGeometry описывает трек слайдера вдоль его оси.
+// По краям трека оставлен отступ в половину ползунка, чтобы ползунок не выходил за виджет.
+type trackGeometry struct {
+	size        fyne.Size
+	thumbSize   float32
+	orientation Orientation
+}
+
+// length возвращает длину трека вдоль оси слайдера
+func (g trackGeometry) length() float32 {
+	if g.orientation == Vertical {
+		return g.size.Height - g.thumbSize
+	}
+	return g.size.Width - g.thumbSize
+}
+
+// ratioAt переводит позицию указателя в положение на треке (0.0-1.0).
+// Возвращает false, если трек ещё не имеет размера.
+func (g trackGeometry) ratioAt(pos fyne.Position) (float64, bool) {
+	usableLength := g.length()
+	if g.size.Width == 0 || g.size.Height == 0 || usableLength <= 0 {
+		return 0, false
+	}
+
+	padding := g.thumbSize / 2
+	offset := pos.X - padding
+	if g.orientation == Vertical {
+		offset = pos.Y - padding
+	}
+
+	if offset < 0 {
+		offset = 0
+	}
+	if offset > usableLength {
+		offset = usableLength
+	}
+
+	ratio := float64(offset / usableLength)
+	if g.orientation == Vertical {
+		ratio = 1 - ratio // Снизу вверх
+	}
+	return ratio, true
+}
+
+// pointAt возвращает центр ползунка для положения на треке (0.0-1.0)
+func (g trackGeometry) pointAt(ratio float64) fyne.Position {
+	padding := g.thumbSize / 2
+	offset := float32(ratio) * g.length()
+	if g.orientation == Vertical {
+		return fyne.NewPos(g.size.Width/2, padding+g.length()-offset)
+	}
+	return fyne.NewPos(padding+offset, g.size.Height/2)
+}
+
+// layoutTrack размещает дорожку толщиной thickness вдоль оси
+func (g trackGeometry) layoutTrack(track fyne.CanvasObject, thickness float32) {
+	padding := g.thumbSize / 2
+	if g.orientation == Vertical {
+		track.Resize(fyne.NewSize(thickness, g.length()))
+		track.Move(fyne.NewPos((g.size.Width-thickness)/2, padding))
+		return
+	}
+	track.Resize(fyne.NewSize(g.length(), thickness))
+	track.Move(fyne.NewPos(padding, (g.size.Height-thickness)/2))
+}
+
+// layoutSegment размещает заливку между положениями from и to (0.0-1.0, from <= to)
+func (g trackGeometry) layoutSegment(fill fyne.CanvasObject, thickness float32, from, to float64) {
+	start := g.pointAt(from)
+	end := g.pointAt(to)
+	if g.orientation == Vertical {
+		// Заливка растет от нижнего края трека
+		fill.Resize(fyne.NewSize(thickness, start.Y-end.Y))
+		fill.Move(fyne.NewPos((g.size.Width-thickness)/2, end.Y))
+		return
+	}
+	fill.Resize(fyne.NewSize(end.X-start.X, thickness))
+	fill.Move(fyne.NewPos(start.X, (g.size.Height-thickness)/2))
+}
+
+// DefaultKeyboardFraction - доля диапазона, на которую стрелки сдвигают слайдер без шага
+const DefaultKeyboardFraction = 0.01
+
+// pageStepMultiplier - во сколько раз PageUp/PageDown сдвигают сильнее стрелок
+const pageStepMultiplier = 10
+
+// DefaultFineFactor - во сколько раз замедляется перетаскивание в режиме точной настройки
+const DefaultFineFactor = 10
+
+// flashFadeTime - длительность затухания вспышки после сброса в секундах
+const flashFadeTime = 0.6
+
+// hoverFadeTime - длительность появления и затухания эффектов наведения в секундах
+const hoverFadeTime = 0.15
+
 // NeonSlider представляет неоновый слайдер с анимацией
 type NeonSlider struct {
-	widget.BaseWidget
+	widget.DisableableWidget
+	neonAnimation
 
 	// Основные параметры слайдера
 	Min, Max, Value float64       // Минимальное, максимальное и текущее значения
 	Step            float64       // ВОССТАНОВЛЕНО: Шаг изменения значения (0 = без ограничений)
+	Scale           Scale         // Шкала значений (nil = линейная), Step отсчитывается в её пространстве
+	DefaultValue    float64       // Значение, к которому слайдер сбрасывается двойным нажатием
 	OnChanged       func(float64) // Callback при изменении значения
 
-	// Параметры анимации (внутренние)
-	glowIntensity  float64   // Текущая интенсивность свечения
-	pulsePhase     float64   // Фаза пульсации
-	shimmerPhase   float64   // Фаза мерцания
-	lastUpdateTime time.Time // Время последнего обновления
+	// Дискретные значения: слайдер принимает только остановки, Step не используется
+	Stops      []Stop                        // Допустимые значения по возрастанию (пусто = непрерывный диапазон)
+	OnSelected func(index int, label string) // Callback при выборе другой остановки
+
+	// Callback'и завершения ввода - для дорогой обработки, которой не нужен каждый пиксель перетаскивания
+	OnChangeEnded func(float64) // Значение зафиксировано: конец перетаскивания, нажатие, клавиша или прокрутка
+	OnDragStart   func(float64) // Начало перетаскивания, передается значение до перетаскивания
+	OnDragEnd     func(float64) // Конец перетаскивания, передается итоговое значение
+
+	// Плавные эффекты наведения (0.0-1.0), догоняют цель на каждом кадре
+	thumbHoverLevel float64 // Подсветка и увеличение ползунка
+	trackHoverLevel float64 // Подсветка дорожки в режиме DragFullTrack
+	flashLevel      float64 // Вспышка свечения после сброса, затухает до нуля
+	tooltipLevel    float64 // Видимость подсказки со значением
 
 	// Состояние взаимодействия
 	isDragging    bool           // Флаг перетаскивания
+	pendingChange bool           // Значение изменено пользователем после последнего OnChangeEnded
+	focused       bool           // Флаг фокуса клавиатуры
+	ReadOnly      bool           // Только чтение: полное свечение, но ввод игнорируется
+	hovered       bool           // Флаг наведения указателя
+	thumbHovered  bool           // Флаг наведения указателя на ползунок
 	DragMode      SliderDragMode // Режим перетаскивания
-	AnimationType AnimationType  // Тип анимации
+	DragBehavior  DragBehavior   // Следование ползунка за указателем
+	Orientation   Orientation    // Ориентация слайдера
+	dragPos       fyne.Position  // Позиция, по которой считается значение при перетаскивании
+	dragStart     float64        // Значение в начале перетаскивания, восстанавливается по Escape
+	dragCancelled bool           // Перетаскивание отменено, события до DragEnd игнорируются
+	snapped       bool           // Ползунок захвачен точкой привязки
+	ticksStale    bool           // Деления нужно пересчитать при следующей отрисовке
+	lastTap       time.Time      // Время предыдущего нажатия для распознавания двойного
+
+	// Модификаторы перетаскивания (читаются из desktop драйвера)
+	FineModifier   fyne.KeyModifier // Клавиши точной настройки (по умолчанию Shift)
+	FineFactor     float64          // Во сколько раз замедляется перетаскивание при точной настройке
+	CoarseModifier fyne.KeyModifier // Клавиши грубой настройки (по умолчанию Ctrl или Alt)
+	CoarseStep     float64          // Шаг грубой настройки (0 = десять шагов клавиатуры)
+
+	// Мягкая привязка при перетаскивании и нажатии
+	SnapPoints []float64 // Точки привязки, например 0 или единичное усиление
+	SnapRadius float32   // Радиус притяжения в пикселях (0 = DefaultSnapRadius)
+
+	// Управление с клавиатуры
+	KeyboardFraction float64 // Доля диапазона на нажатие стрелки при Step == 0
+
+	// Управление прокруткой, по умолчанию отключено. Слайдер перехватывает прокрутку
+	// у родительского container.Scroll даже тогда, когда сам её игнорирует: страница
+	// не прокручивается, пока указатель над слайдером.
+	ScrollActivation  ScrollActivation // Когда прокрутка меняет значение
+	ScrollSensitivity float64          // Множитель прокрутки (1.0 = один шаг на щелчок колеса)
+	ScrollInverted    bool             // Инвертировать направление прокрутки
+	scrollAccum       float32          // Накопленная прокрутка трекпада, не достигшая щелчка
 
 	// Визуальные настройки
-	Colors NeonColors // Цветовая схема
+	Colors    NeonColors     // Цветовая схема
+	Formatter ValueFormatter // Текстовое представление значения (nil = знаки по шагу)
+	Tooltip   TooltipMode    // Подсказка со значением над ползунком
+
+	// Деления шкалы
+	TickPlacement TickPlacement // Расположение делений (по умолчанию не рисуются)
+	MajorTicks    []float64     // Значения крупных делений (пусто вместе с MinorTicks = по шагу)
+	MinorTicks    []float64     // Значения мелких делений
+	TickLabels    bool          // Подписывать крупные деления через Formatter
+
+	// Внутренний слушатель значения для составных виджетов (вызывается вместе с OnChanged)
+	valueListener func(float64)
+
+	// Привязка данных (внутренние)
+	data         binding.Float        // Привязанный источник данных
+	dataListener binding.DataListener // Слушатель изменений источника
 
 	// Геометрия (внутренние параметры)
 	thumbCenter fyne.Position       // Центр ползунка
@@ -249,6 +507,13 @@ func NewWithSettings(min, max float64, colors NeonColors,
 	return slider
 }
 
+// NewWithData создает слайдер, связанный с источником данных binding.Float
+func NewWithData(min, max float64, data binding.Float) *NeonSlider {
+	slider := New(min, max)
+	slider.Bind(data)
+	return slider
+}
+
 // NewWithColorAndMode создает слайдер с полной настройкой (без шага)
 func NewWithColorAndMode(min, max float64, colors NeonColors, dragMode SliderDragMode) *NeonSlider {
 	return NewWithColorAndModeAndStep(min, max, 0, colors, dragMode, AnimationWave)
@@ -258,46 +523,116 @@ func NewWithColorAndMode(min, max float64, colors NeonColors, dragMode SliderDra
 func NewWithColorAndModeAndStep(min, max, step float64, colors NeonColors,
 	dragMode SliderDragMode, animType AnimationType) *NeonSlider {
 	slider := &NeonSlider{
-		Min:            min,
-		Max:            max,
-		Value:          min,
-		Step:           step, // ВОССТАНОВЛЕНО: Устанавливаем шаг
-		DragMode:       dragMode,
-		Colors:         colors,
-		AnimationType:  animType,
-		thumbSize:      32,
-		glowIntensity:  colors.MinIntensity,
-		lastUpdateTime: time.Now(),
+		Min:               min,
+		Max:               max,
+		Value:             min,
+		DefaultValue:      min,
+		Step:              step, // ВОССТАНОВЛЕНО: Устанавливаем шаг
+		DragMode:          dragMode,
+		Colors:            colors,
+		thumbSize:         32,
+		KeyboardFraction:  DefaultKeyboardFraction,
+		FineModifier:      fyne.KeyModifierShift,
+		FineFactor:        DefaultFineFactor,
+		CoarseModifier:    fyne.KeyModifierControl | fyne.KeyModifierAlt,
+		ScrollSensitivity: 1,
 	}
+	slider.neonAnimation = newNeonAnimation(slider, animType, colors)
 
 	slider.ExtendBaseWidget(slider)
 	return slider
 }
 
-// SetValue устанавливает значение слайдера с учетом шага
+// SetValue устанавливает значение слайдера с учетом шага. NaN игнорируется.
 func (n *NeonSlider) SetValue(value float64) {
-	// ВОССТАНОВЛЕНО: Применяем шаг при установке значения
-	if n.Step > 0 {
-		value = roundToStep(value, n.Min, n.Max, n.Step)
-	} else {
-		// Ограничиваем значение диапазоном без шага
-		if value < n.Min {
-			value = n.Min
-		}
-		if value > n.Max {
-			value = n.Max
-		}
+	if math.IsNaN(value) {
+		return
+	}
+
+	// ВОССТАНОВЛЕНО: Применяем шаг (или ближайшую остановку) при установке значения
+	value = n.snap(value)
+	if math.IsNaN(value) {
+		return // Пользовательская шкала не определена для этого значения
 	}
 
-	// Обновляем значение и вызываем callback
+	// Обновляем значение, источник данных и вызываем callback
 	if n.Value != value {
 		n.Value = value
+		n.writeData(value)
+		if n.valueListener != nil {
+			n.valueListener(value)
+		}
 		if n.OnChanged != nil {
 			n.OnChanged(value)
 		}
+		n.fireSelected()
 	}
 
-	n.Refresh()
+	n.redraw()
+}
+
+// Refresh перерисовывает слайдер и пересчитывает деления шкалы.
+// Вызывайте после изменения полей слайдера напрямую.
+func (n *NeonSlider) Refresh() {
+	n.ticksStale = true
+	n.DisableableWidget.Refresh()
+}
+
+// redraw перерисовывает слайдер без пересчета делений - для кадров анимации
+// и изменений, не затрагивающих шкалу
+func (n *NeonSlider) redraw() {
+	n.DisableableWidget.Refresh()
+}
+
+// Bind связывает слайдер с источником данных.
+// Изменения источника переносятся в слайдер с учетом шага, а действия
+// пользователя записываются обратно в источник.
+func (n *NeonSlider) Bind(data binding.Float) {
+	n.Unbind()
+
+	n.data = data
+	n.dataListener = binding.NewDataListener(n.updateFromData)
+	data.AddListener(n.dataListener)
+}
+
+// Unbind отключает слайдер от источника данных, текущее значение сохраняется
+func (n *NeonSlider) Unbind() {
+	if n.data == nil {
+		return
+	}
+	n.data.RemoveListener(n.dataListener)
+	n.data = nil
+	n.dataListener = nil
+}
+
+// updateFromData переносит значение из источника данных в слайдер
+func (n *NeonSlider) updateFromData() {
+	data := n.data
+	if data == nil {
+		return
+	}
+	value, err := data.Get()
+	if err != nil {
+		fyne.LogError("Error getting current data value", err)
+		return
+	}
+
+	n.SetValue(value)
+
+	// Значение было скорректировано шагом или границами - возвращаем его в источник
+	if n.Value != value {
+		n.writeData(n.Value)
+	}
+}
+
+// writeData записывает значение в привязанный источник данных
+func (n *NeonSlider) writeData(value float64) {
+	if n.data == nil {
+		return
+	}
+	if err := n.data.Set(value); err != nil {
+		fyne.LogError("Error setting current data value", err)
+	}
 }
 
 // GetValue возвращает текущее значение слайдера
@@ -305,17 +640,27 @@ func (n *NeonSlider) GetValue() float64 {
 	return n.Value
 }
 
+// SetDefaultValue задает значение для сброса двойным нажатием
+func (n *NeonSlider) SetDefaultValue(value float64) {
+	n.DefaultValue = value
+}
+
+// Reset возвращает слайдер к DefaultValue и подсвечивает сброс вспышкой
+func (n *NeonSlider) Reset() {
+	n.SetValue(n.DefaultValue)
+	n.flashLevel = 1
+}
+
 // ВОССТАНОВЛЕНО: SetStep устанавливает шаг изменения значения
 func (n *NeonSlider) SetStep(step float64) {
 	if step < 0 {
 		step = 0
 	}
 	n.Step = step
+	n.ticksStale = true
 
 	// Перепроверяем текущее значение с новым шагом
-	if step > 0 {
-		n.SetValue(n.Value) // Это пересчитает значение с учетом нового шага
-	}
+	n.SetValue(n.Value) // Это пересчитает значение с учетом нового шага
 }
 
 // ВОССТАНОВЛЕНО: GetStep возвращает текущий шаг
@@ -330,203 +675,302 @@ func (n *NeonSlider) SetColors(colors NeonColors) {
 	n.Refresh()
 }
 
-// SetDragMode изменяет режим перетаскивания
-func (n *NeonSlider) SetDragMode(mode SliderDragMode) {
-	n.DragMode = mode
+// SetScale задает шкалу значений и заново округляет текущее значение к шагу.
+// nil возвращает линейную шкалу. Шкала, не определенная на границах диапазона
+// (LogScale при Min <= 0), работает как линейная.
+func (n *NeonSlider) SetScale(scale Scale) {
+	n.Scale = scale
+	n.ticksStale = true
+	n.SetValue(n.Value)
 }
 
-// SetAnimationType изменяет тип анимации
-func (n *NeonSlider) SetAnimationType(animType AnimationType) {
-	n.AnimationType = animType
+// SetFormatter задает текстовое представление значения.
+// nil возвращает форматирование по умолчанию - знаки после запятой по шагу.
+func (n *NeonSlider) SetFormatter(formatter ValueFormatter) {
+	n.Formatter = formatter
+	if n.valueListener != nil {
+		n.valueListener(n.Value)
+	}
+	n.Refresh()
 }
 
-// StartAnimation запускает анимацию слайдера
-func (n *NeonSlider) StartAnimation() {
-	go func() {
-		ticker := time.NewTicker(16 * time.Millisecond) // 60 FPS
-		defer ticker.Stop()
-		startTime := time.Now()
-
-		for range ticker.C {
-			elapsed := time.Since(startTime).Seconds()
+// FormatValue переводит значение в текст через Formatter слайдера
+func (n *NeonSlider) FormatValue(value float64) string {
+	return n.formatter().Format(value)
+}
 
-			if elapsed > 86400 { // Сброс каждые 24 часа
-				startTime = time.Now()
-				elapsed = 0
-			}
+// ValueText возвращает текущее значение в виде текста, например для подписи или описания
+func (n *NeonSlider) ValueText() string {
+	return n.FormatValue(n.Value)
+}
 
-			fyne.Do(func() {
-				n.updateSmoothGlow(elapsed)
-				n.Refresh()
-			})
+// formatter возвращает заданное форматирование или форматирование по шагу (по остановкам).
+// Подписи остановок заменяют числа при выводе и принимаются при вводе.
+func (n *NeonSlider) formatter() ValueFormatter {
+	var formatter ValueFormatter = StepFormatter(n.Step)
+	if n.Formatter != nil {
+		formatter = n.Formatter
+	} else if len(n.Stops) > 0 {
+		formatter = n.stopValueFormatter()
+	}
+	for _, stop := range n.Stops {
+		if stop.Label != "" {
+			return stopFormatter{stops: n.Stops, base: formatter}
 		}
-	}()
+	}
+	return formatter
 }
 
-// КАРДИНАЛЬНО УЛУЧШЕННЫЕ методы анимации для большей заметности
-
-// updateWaveAnimation - волновая анимация с многослойными эффектами
-func (n *NeonSlider) updateWaveAnimation(elapsed float64) {
-	baseTime := elapsed * n.Colors.AnimationSpeed * n.Colors.WaveFrequency
+// SetDragMode изменяет режим перетаскивания
+func (n *NeonSlider) SetDragMode(mode SliderDragMode) {
+	n.DragMode = mode
+}
 
-	// Основная волна
-	mainWave := math.Sin(baseTime * 1.5)
-	// Вторичная волна для сложности
-	secondWave := math.Sin(baseTime*2.3) * 0.4
-	// Быстрое мерцание для живости
-	flicker := math.Sin(baseTime*8.0) * 0.15
+// SetDragBehavior изменяет поведение ползунка при перетаскивании
+func (n *NeonSlider) SetDragBehavior(behavior DragBehavior) {
+	n.DragBehavior = behavior
+}
 
-	// Комбинируем все волны
-	combinedWave := (mainWave + secondWave + flicker) * n.Colors.WaveAmplitude
+// SetOrientation изменяет ориентацию слайдера
+func (n *NeonSlider) SetOrientation(orientation Orientation) {
+	if n.Orientation == orientation {
+		return
+	}
+	n.Orientation = orientation
+	n.Refresh()
+}
 
-	// Нормализуем и применяем easing
-	normalizedWave := (combinedWave + 1.0) / 2.0
-	smoothWave := smootherstep(normalizedWave)
+// StepAnimation рассчитывает один кадр анимации по текущему времени часов.
+// Работает и без запущенного планировщика, что позволяет проверять
+// анимацию покадрово вместе с ManualClock.
+func (n *NeonSlider) StepAnimation() {
+	now, dt := n.tick()
 
-	pulseRange := n.Colors.MaxIntensity - n.Colors.MinIntensity
-	n.glowIntensity = n.Colors.MinIntensity + pulseRange*smoothWave
+	// После паузы не перескакиваем эффекты за один кадр
+	dt = math.Max(0, math.Min(dt, 0.1))
+	n.updateHoverLevels(dt / hoverFadeTime)
+	n.flashLevel = approach(n.flashLevel, 0, dt/flashFadeTime)
 
-	// Обновляем дополнительные фазы для рендера
-	n.pulsePhase = smoothWave * 0.4                               // Увеличено
-	n.shimmerPhase = ((math.Sin(baseTime*5.5) + 1.0) / 2.0) * 0.3 // Увеличено
+	n.updateSmoothGlow(now)
 }
 
-// updatePulseAnimation - пульсирующая анимация с резкими всплесками
-func (n *NeonSlider) updatePulseAnimation(elapsed float64) {
-	pulseTime := elapsed * n.Colors.AnimationSpeed * 2.5 // Ускорено
+// hoverTargets возвращает целевые уровни подсветки ползунка и дорожки
+func (n *NeonSlider) hoverTargets() (thumb, track float64) {
+	if !n.interactive() {
+		return 0, 0
+	}
+	if n.thumbHovered || n.isDragging {
+		thumb = 1
+	}
+	if n.hovered && n.DragMode == DragFullTrack {
+		track = 1
+	}
+	return thumb, track
+}
 
-	// Основная пульсация
-	rawPulse := math.Sin(pulseTime * math.Pi)
-	normalizedPulse := (rawPulse + 1.0) / 2.0
-	smoothPulse := easeInOutCubic(normalizedPulse)
+// updateHoverLevels приближает уровни подсветки и видимость подсказки к целевым не более чем на delta
+func (n *NeonSlider) updateHoverLevels(delta float64) {
+	thumb, track := n.hoverTargets()
+	n.thumbHoverLevel = approach(n.thumbHoverLevel, thumb, delta)
+	n.trackHoverLevel = approach(n.trackHoverLevel, track, delta)
+	n.tooltipLevel = approach(n.tooltipLevel, n.tooltipTarget(), delta)
+}
 
-	// Добавляем быстрые всплески
-	burstTime := elapsed * n.Colors.AnimationSpeed * 7.0
-	burst := math.Max(0, math.Sin(burstTime)) * 0.3
+// approach сдвигает current к target не более чем на delta
+func approach(current, target, delta float64) float64 {
+	if current < target {
+		return math.Min(current+delta, target)
+	}
+	return math.Max(current-delta, target)
+}
 
-	pulseRange := n.Colors.MaxIntensity - n.Colors.MinIntensity
-	basePulse := smoothPulse * n.Colors.PulseStrength
+// updateSmoothGlow - главная функция обновления анимации
+func (n *NeonSlider) updateSmoothGlow(now time.Time) {
+	if !n.updateGlow(now, &n.Colors, n.isDragging) {
+		return
+	}
 
-	n.glowIntensity = n.Colors.MinIntensity + pulseRange*(basePulse+burst)
+	// Вспышка поднимает свечение до максимума и плавно затухает
+	if n.flashLevel > 0 {
+		flash := smoothstep(n.flashLevel)
+		n.glowIntensity += (n.Colors.MaxIntensity - n.glowIntensity) * flash
+		n.pulsePhase += 0.5 * flash
+		n.shimmerPhase += 0.4 * flash
+	}
+}
 
-	// Сильные эффекты для пульсации
-	n.pulsePhase = (smoothPulse + burst) * 0.5 // Увеличено
-	n.shimmerPhase = burst * 0.4               // Новый эффект
+// isPointInThumb проверяет, находится ли точка внутри ползунка
+func (n *NeonSlider) isPointInThumb(pos fyne.Position) bool {
+	return pointInThumb(pos, n.thumbCenter, n.thumbSize)
 }
 
-// updateBreathingAnimation - "дыхание" с медленными мощными переходами
-func (n *NeonSlider) updateBreathingAnimation(elapsed float64) {
-	breathTime := elapsed * n.Colors.BreathingSpeed
+// pointInThumb проверяет попадание точки в ползунок с центром center с запасом в 10 пикселей
+func pointInThumb(pos, center fyne.Position, thumbSize float32) bool {
+	hitRadius := thumbSize/2 + 10
+	dx := pos.X - center.X
+	dy := pos.Y - center.Y
+	distance := float32(math.Sqrt(float64(dx*dx + dy*dy)))
+	return distance <= hitRadius
+}
 
-	// Медленное основное дыхание
-	rawBreath := math.Sin(breathTime * math.Pi * 0.4) // Медленнее
-	normalizedBreath := (rawBreath + 1.0) / 2.0
-	// Тройное сглаживание для супер-плавности
-	smoothBreath := smootherstep(smootherstep(smootherstep(normalizedBreath)))
+// geometry возвращает геометрию трека для текущего размера виджета
+func (n *NeonSlider) geometry(size fyne.Size) trackGeometry {
+	return trackGeometry{size: size, thumbSize: n.thumbSize, orientation: n.Orientation}
+}
 
-	// Добавляем тонкое мерцание на пиках
-	peakFlicker := 0.0
-	if smoothBreath > 0.8 {
-		flickerTime := elapsed * n.Colors.AnimationSpeed * 12.0
-		peakFlicker = math.Sin(flickerTime) * 0.1 * (smoothBreath - 0.8) * 5.0
+// valueAt переводит позицию указателя в значение по шкале без учета шага
+func (n *NeonSlider) valueAt(pos fyne.Position) (float64, bool) {
+	ratio, ok := n.geometry(n.Size()).ratioAt(pos)
+	if !ok {
+		return 0, false
 	}
+	return ratioValue(n.Scale, ratio, n.Min, n.Max), true
+}
 
-	pulseRange := n.Colors.MaxIntensity - n.Colors.MinIntensity
-	breathEffect := smoothBreath * n.Colors.BreathingDepth
+// updateValueFromPosition обновляет значение слайдера на основе позиции мыши
+func (n *NeonSlider) updateValueFromPosition(pos fyne.Position) {
+	newValue, ok := n.valueAt(pos)
+	if !ok {
+		return
+	}
 
-	n.glowIntensity = n.Colors.MinIntensity + pulseRange*(breathEffect+peakFlicker)
+	// Нажатие на точку привязки всегда дает вспышку
+	n.snapped = false
 
-	// Мягкие дополнительные эффекты
-	n.pulsePhase = smoothBreath * 0.3  // Мягко
-	n.shimmerPhase = peakFlicker * 0.5 // Только на пиках
+	// ВОССТАНОВЛЕНО: Применяем шаг при перетаскивании
+	n.changeValueAttracted(newValue) // SetValue уже учитывает шаг
 }
 
-// updateSmoothGlow - главная функция обновления анимации
-func (n *NeonSlider) updateSmoothGlow(elapsed float64) {
-	if elapsed < 0 || math.IsNaN(elapsed) || math.IsInf(elapsed, 0) {
-		return
+// changeValue устанавливает значение по действию пользователя и запоминает,
+// что изменение еще не зафиксировано через OnChangeEnded
+func (n *NeonSlider) changeValue(value float64) {
+	before := n.Value
+	n.SetValue(value)
+	if n.Value != before {
+		n.pendingChange = true
 	}
+}
 
-	// Выбираем анимацию
-	switch n.AnimationType {
-	case AnimationWave:
-		n.updateWaveAnimation(elapsed)
-	case AnimationPulse:
-		n.updatePulseAnimation(elapsed)
-	case AnimationBreathing:
-		n.updateBreathingAnimation(elapsed)
-	default:
-		n.updateWaveAnimation(elapsed) // По умолчанию волновая
+// fireChangeEnded вызывает OnChangeEnded, если со времени прошлого вызова значение менялось.
+// Во время перетаскивания фиксация откладывается до DragEnd.
+func (n *NeonSlider) fireChangeEnded() {
+	if !n.pendingChange || n.isDragging {
+		return
 	}
-
-	// Гарантируем границы
-	n.glowIntensity = math.Max(n.Colors.MinIntensity,
-		math.Min(n.glowIntensity, n.Colors.MaxIntensity))
-
-	// УСИЛЕННЫЙ эффект при перетаскивании
-	if n.isDragging {
-		dragBoost := (n.Colors.MaxIntensity - n.Colors.MinIntensity) * 0.3 // Увеличено
-		maxPossible := n.Colors.MaxIntensity - n.glowIntensity
-		if dragBoost > maxPossible {
-			dragBoost = maxPossible
-		}
-		n.glowIntensity += dragBoost
-		n.pulsePhase *= 1.5 // Усиливаем все эффекты
-		n.shimmerPhase *= 1.5
+	n.pendingChange = false
+	if n.OnChangeEnded != nil {
+		n.OnChangeEnded(n.Value)
 	}
 }
 
-// isPointInThumb проверяет, находится ли точка внутри ползунка
-func (n *NeonSlider) isPointInThumb(pos fyne.Position) bool {
-	hitRadius := n.thumbSize/2 + 10
-	dx := pos.X - n.thumbCenter.X
-	dy := pos.Y - n.thumbCenter.Y
-	distance := float32(math.Sqrt(float64(dx*dx + dy*dy)))
-	return distance <= hitRadius
+// SetReadOnly включает режим только для чтения: слайдер светится как обычно,
+// но не реагирует на мышь, клавиатуру и прокрутку. Начатое перетаскивание
+// завершается с текущим значением.
+func (n *NeonSlider) SetReadOnly(readOnly bool) {
+	n.ReadOnly = readOnly
+	if readOnly {
+		n.endDrag()
+	}
 }
 
-// updateValueFromPosition обновляет значение слайдера на основе позиции мыши
-func (n *NeonSlider) updateValueFromPosition(x float32) {
-	size := n.Size()
-	if size.Width == 0 {
-		return
+// Disable отключает слайдер: свечение приглушается, анимация и ввод останавливаются.
+// Начатое перетаскивание завершается с текущим значением.
+func (n *NeonSlider) Disable() {
+	n.endDrag()
+	n.thumbHoverLevel, n.trackHoverLevel = 0, 0
+	if n.Tooltip != TooltipAlways {
+		n.tooltipLevel = 0
 	}
+	n.DisableableWidget.Disable()
+}
 
-	padding := n.thumbSize / 2
-	usableWidth := size.Width - padding*2
-	adjustedX := x - padding
+// interactive сообщает, может ли пользователь менять значение
+func (n *NeonSlider) interactive() bool {
+	return !n.Disabled() && !n.ReadOnly
+}
 
-	if adjustedX < 0 {
-		adjustedX = 0
+// keyStep возвращает сдвиг на одно нажатие стрелки в пространстве шкалы
+func (n *NeonSlider) keyStep() float64 {
+	if n.Step > 0 {
+		return n.Step
 	}
-	if adjustedX > usableWidth {
-		adjustedX = usableWidth
+	fraction := n.KeyboardFraction
+	if fraction <= 0 {
+		fraction = DefaultKeyboardFraction
 	}
+	low, high := scaledRange(scaleFor(n.Scale, n.Min, n.Max), n.Min, n.Max)
+	return (high - low) * fraction
+}
 
-	if usableWidth == 0 {
+// stepBy сдвигает значение на steps нажатий стрелки по шкале слайдера.
+// С остановками каждое нажатие переходит к соседней остановке.
+func (n *NeonSlider) stepBy(steps float64) {
+	if len(n.Stops) > 0 {
+		n.stepStops(int(steps))
 		return
 	}
+	n.changeValue(offsetValue(n.Scale, n.Value, steps*n.keyStep(), n.Min, n.Max))
+}
 
-	ratio := float64(adjustedX / usableWidth)
-	newValue := n.Min + ratio*(n.Max-n.Min)
-
-	// ВОССТАНОВЛЕНО: Применяем шаг при перетаскивании
-	n.SetValue(newValue) // SetValue уже учитывает шаг
+// requestFocus передает слайдеру фокус клавиатуры (кроме мобильных устройств)
+func (n *NeonSlider) requestFocus() {
+	app := fyne.CurrentApp()
+	if n.focused || app == nil || app.Driver().Device().IsMobile() {
+		return
+	}
+	if c := app.Driver().CanvasForObject(n); c != nil {
+		c.Focus(n)
+	}
 }
 
 // Реализация интерфейсов взаимодействия
 func (n *NeonSlider) Tapped(e *fyne.PointEvent) {
+	if n.Disabled() {
+		return
+	}
+	n.requestFocus()
+	if n.ReadOnly {
+		return
+	}
+	if n.isDoubleTap() {
+		n.userReset()
+		return
+	}
+
 	switch n.DragMode {
 	case DragThumbOnly:
 		if n.isPointInThumb(e.Position) {
-			n.updateValueFromPosition(e.Position.X)
+			n.updateValueFromPosition(e.Position)
 		}
 	case DragFullTrack:
-		n.updateValueFromPosition(e.Position.X)
+		n.updateValueFromPosition(e.Position)
 	}
+	n.fireChangeEnded()
+}
+
+// isDoubleTap запоминает нажатие и сообщает, завершает ли оно двойное нажатие.
+// Слайдер распознает двойное нажатие сам, а не через fyne.DoubleTappable:
+// иначе Fyne задерживал бы каждое нажатие на интервал двойного нажатия.
+func (n *NeonSlider) isDoubleTap() bool {
+	delay := 300 * time.Millisecond
+	if app := fyne.CurrentApp(); app != nil {
+		delay = app.Driver().DoubleTapDelay()
+	}
+
+	now := time.Now()
+	if !n.lastTap.IsZero() && now.Sub(n.lastTap) <= delay {
+		n.lastTap = time.Time{} // Третье нажатие начинает новую пару
+		return true
+	}
+	n.lastTap = now
+	return false
 }
 
 func (n *NeonSlider) Dragged(e *fyne.DragEvent) {
+	if !n.interactive() || n.dragCancelled {
+		return
+	}
+
 	if !n.isDragging {
 		startPos := fyne.NewPos(e.Position.X-e.Dragged.DX, e.Position.Y-e.Dragged.DY)
 		switch n.DragMode {
@@ -537,20 +981,260 @@ func (n *NeonSlider) Dragged(e *fyne.DragEvent) {
 		case DragFullTrack:
 			n.isDragging = true
 		}
+
+		if n.isDragging {
+			n.startDrag(startPos)
+		}
 	}
 
 	if n.isDragging {
-		n.updateValueFromPosition(e.Position.X)
+		n.dragTo(e.Dragged, currentKeyModifiers())
+	}
+}
+
+// dragTo сдвигает позицию перетаскивания на delta с учетом модификаторов:
+// точная настройка уменьшает сдвиг в FineFactor раз и отключает точки привязки,
+// грубая - округляет к CoarseStep
+func (n *NeonSlider) dragTo(delta fyne.Delta, modifiers fyne.KeyModifier) {
+	if n.FineModifier != 0 && modifiers&n.FineModifier != 0 {
+		factor := float32(n.FineFactor)
+		if factor <= 0 {
+			factor = DefaultFineFactor
+		}
+		delta = fyne.NewDelta(delta.DX/factor, delta.DY/factor)
+	}
+	n.dragPos = n.dragPos.Add(delta)
+
+	value, ok := n.valueAt(n.dragPos)
+	if !ok {
+		return
+	}
+	switch {
+	case n.CoarseModifier != 0 && modifiers&n.CoarseModifier != 0:
+		n.changeValue(snapValue(n.Scale, value, n.Min, n.Max, n.coarseStep()))
+	case n.FineModifier != 0 && modifiers&n.FineModifier != 0:
+		n.changeValue(value) // Точная настройка не притягивается к точкам привязки
+	default:
+		n.changeValueAttracted(value)
+	}
+}
+
+// coarseStep возвращает шаг грубой настройки
+func (n *NeonSlider) coarseStep() float64 {
+	if n.CoarseStep > 0 {
+		return n.CoarseStep
+	}
+	return n.keyStep() * pageStepMultiplier
+}
+
+// currentKeyModifiers возвращает зажатые клавиши-модификаторы, если драйвер их сообщает
+func currentKeyModifiers() fyne.KeyModifier {
+	app := fyne.CurrentApp()
+	if app == nil {
+		return 0
+	}
+	if d, ok := app.Driver().(desktop.Driver); ok {
+		return d.CurrentKeyModifiers()
+	}
+	return 0
+}
+
+// startDrag запоминает точку отсчета перетаскивания.
+// В режиме DragRelative при захвате за ползунок отсчет ведется от его центра,
+// поэтому смещение точки захвата сохраняется и ползунок не прыгает под указатель.
+// Перетаскивание, начатое на треке, ведется от точки нажатия.
+func (n *NeonSlider) startDrag(startPos fyne.Position) {
+	// Фокус нужен, чтобы получить Escape для отмены
+	n.requestFocus()
+	n.dragStart = n.Value
+	_, n.snapped = n.attract(n.Value) // Уже захваченная точка не вспыхивает повторно
+
+	if n.DragBehavior != DragJump && n.isPointInThumb(startPos) {
+		n.dragPos = n.thumbCenter
+	} else {
+		n.dragPos = startPos
+	}
+
+	if n.OnDragStart != nil {
+		n.OnDragStart(n.Value)
+	}
+}
+
+// cancelDrag прерывает перетаскивание и возвращает значение, бывшее до его начала.
+// OnChangeEnded не вызывается: пользователь ничего не зафиксировал.
+func (n *NeonSlider) cancelDrag() {
+	n.isDragging = false
+	n.dragCancelled = true
+	n.SetValue(n.dragStart)
+	n.pendingChange = false
+
+	if n.OnDragEnd != nil {
+		n.OnDragEnd(n.Value)
 	}
 }
 
 func (n *NeonSlider) DragEnd() {
+	if n.dragCancelled {
+		n.dragCancelled = false
+		return
+	}
+	n.endDrag()
+}
+
+// endDrag завершает перетаскивание, фиксируя текущее значение через
+// OnChangeEnded и OnDragEnd. Без перетаскивания ничего не делает.
+func (n *NeonSlider) endDrag() {
+	if !n.isDragging {
+		return
+	}
 	n.isDragging = false
+
+	n.fireChangeEnded()
+	if n.OnDragEnd != nil {
+		n.OnDragEnd(n.Value)
+	}
+}
+
+// FocusGained вызывается при получении фокуса клавиатуры
+func (n *NeonSlider) FocusGained() {
+	n.focused = true
+	n.redraw()
+}
+
+// FocusLost вызывается при потере фокуса клавиатуры
+func (n *NeonSlider) FocusLost() {
+	n.focused = false
+	n.redraw()
+}
+
+// TypedRune не используется: слайдер управляется только клавишами навигации
+func (n *NeonSlider) TypedRune(rune) {}
+
+// TypedKey сдвигает значение стрелками на шаг, PageUp/PageDown - на десять шагов,
+// Home/End переводят слайдер в Min/Max, Escape отменяет текущее перетаскивание
+func (n *NeonSlider) TypedKey(e *fyne.KeyEvent) {
+	if !n.interactive() {
+		return
+	}
+	if e.Name == fyne.KeyEscape && n.isDragging {
+		n.cancelDrag()
+		return
+	}
+	switch e.Name {
+	case fyne.KeyRight, fyne.KeyUp:
+		n.stepBy(1)
+	case fyne.KeyLeft, fyne.KeyDown:
+		n.stepBy(-1)
+	case fyne.KeyPageUp:
+		n.stepBy(pageStepMultiplier)
+	case fyne.KeyPageDown:
+		n.stepBy(-pageStepMultiplier)
+	case fyne.KeyHome:
+		n.changeValue(n.Min)
+	case fyne.KeyEnd:
+		n.changeValue(n.Max)
+	}
+	n.fireChangeEnded()
+}
+
+// Scrolled сдвигает значение на шаг за каждый щелчок колеса мыши.
+// Плавная прокрутка трекпада накапливается до величины щелчка.
+func (n *NeonSlider) Scrolled(e *fyne.ScrollEvent) {
+	if !n.interactive() {
+		return
+	}
+
+	switch n.ScrollActivation {
+	case ScrollOnHover:
+		if !n.hovered {
+			return
+		}
+	case ScrollOnFocus:
+		if !n.focused {
+			return
+		}
+	default:
+		return
+	}
+
+	// Берем преобладающую ось: вертикальное колесо или горизонтальный жест трекпада
+	delta := e.Scrolled.DY
+	if math.Abs(float64(e.Scrolled.DX)) > math.Abs(float64(e.Scrolled.DY)) {
+		delta = e.Scrolled.DX
+	}
+	if n.ScrollInverted {
+		delta = -delta
+	}
+
+	// Событие не меньше щелчка считается ровно одним щелчком: так шаг не зависит
+	// от ускорения прокрутки и размера щелчка на платформе. Мелкие смещения
+	// трекпада накапливаются.
+	if math.Abs(float64(delta)) >= float64(scrollNotch) {
+		n.scrollAccum = 0
+		delta = float32(math.Copysign(float64(scrollNotch), float64(delta)))
+	}
+
+	sensitivity := n.ScrollSensitivity
+	if sensitivity <= 0 {
+		sensitivity = 1
+	}
+	n.scrollAccum += delta * float32(sensitivity)
+
+	notches := float32(math.Trunc(float64(n.scrollAccum / scrollNotch)))
+	if notches == 0 {
+		return
+	}
+	n.scrollAccum -= notches * scrollNotch
+	n.stepBy(float64(notches))
+	n.fireChangeEnded()
+}
+
+func (n *NeonSlider) MouseIn(e *desktop.MouseEvent) {
+	n.hovered = true
+	n.thumbHovered = n.isPointInThumb(e.Position)
+	n.hoverChanged()
+}
+
+func (n *NeonSlider) MouseOut() {
+	n.hovered = false
+	n.thumbHovered = false
+	n.scrollAccum = 0
+	n.hoverChanged()
+}
+
+func (n *NeonSlider) MouseMoved(e *desktop.MouseEvent) {
+	if over := n.isPointInThumb(e.Position); over != n.thumbHovered {
+		n.thumbHovered = over
+		n.hoverChanged()
+	}
+}
+
+// hoverChanged применяет изменение наведения. Плавный переход ведет цикл анимации,
+// а без анимации подсветка переключается сразу.
+func (n *NeonSlider) hoverChanged() {
+	if n.IsAnimating() && !n.Disabled() {
+		return
+	}
+	n.updateHoverLevels(1)
+	n.redraw()
 }
 
-func (n *NeonSlider) MouseIn(*desktop.MouseEvent)      {}
-func (n *NeonSlider) MouseOut()                        {}
-func (n *NeonSlider) MouseMoved(e *desktop.MouseEvent) {}
+// Cursor возвращает курсор для текущего положения указателя
+func (n *NeonSlider) Cursor() desktop.Cursor {
+	if !n.interactive() {
+		return desktop.DefaultCursor
+	}
+	if n.isDragging {
+		if n.Orientation == Vertical {
+			return desktop.VResizeCursor
+		}
+		return desktop.HResizeCursor
+	}
+	if n.thumbHovered || n.DragMode == DragFullTrack {
+		return desktop.PointerCursor
+	}
+	return desktop.DefaultCursor
+}
 
 // CreateRenderer создает рендерер для слайдера
 func (n *NeonSlider) CreateRenderer() fyne.WidgetRenderer {
@@ -558,74 +1242,112 @@ func (n *NeonSlider) CreateRenderer() fyne.WidgetRenderer {
 	fill := canvas.NewRectangle(color.RGBA{R: n.Colors.PrimaryR, G: n.Colors.PrimaryG, B: n.Colors.PrimaryB, A: 200})
 	thumb := canvas.NewCircle(color.RGBA{R: n.Colors.PrimaryR, G: n.Colors.PrimaryG, B: n.Colors.PrimaryB, A: 255})
 
+	focusRing := canvas.NewRectangle(color.Transparent)
+
 	track.CornerRadius = 10
 	fill.CornerRadius = 10
+	focusRing.CornerRadius = 16
+	focusRing.StrokeWidth = 2
+	focusRing.Hide()
+
+	tooltip, tooltipText := newTooltip()
 
 	renderer := &neonSliderRenderer{
-		slider: n,
-		track:  track,
-		fill:   fill,
-		thumb:  thumb,
+		slider:      n,
+		track:       track,
+		fill:        fill,
+		thumb:       thumb,
+		focusRing:   focusRing,
+		tooltip:     tooltip,
+		tooltipText: tooltipText,
 	}
 
+	// Fyne может пересоздавать рендерер; StartAnimation идемпотентен,
+	// поэтому у слайдера всегда остаётся одна запись в планировщике
 	n.renderer = renderer
+	renderer.updateTicks()
 	n.StartAnimation()
 
 	return renderer
 }
 
+// trackThickness - толщина дорожки слайдера
+const trackThickness = float32(20)
+
 // neonSliderRenderer отвечает за отрисовку слайдера
 type neonSliderRenderer struct {
-	slider *NeonSlider
-	track  *canvas.Rectangle
-	fill   *canvas.Rectangle
-	thumb  *canvas.Circle
+	slider    *NeonSlider
+	track     *canvas.Rectangle
+	fill      *canvas.Rectangle
+	thumb     *canvas.Circle
+	focusRing *canvas.Rectangle // Неоновая рамка фокуса клавиатуры
+
+	// Деления шкалы, пересчитываются после NeonSlider.Refresh
+	ticks      []tick
+	tickLines  []*canvas.Line
+	tickLabels []*canvas.Text
+
+	// Подсказка со значением над ползунком
+	tooltip     *canvas.Rectangle
+	tooltipText *canvas.Text
 }
 
 func (r *neonSliderRenderer) Layout(size fyne.Size) {
-	if r.track == nil || r.fill == nil || r.thumb == nil {
+	if r.track == nil || r.fill == nil || r.thumb == nil || r.focusRing == nil {
 		return
 	}
 
-	trackHeight := float32(20)
-	thumbSize := r.slider.thumbSize
-	padding := thumbSize / 2
-	trackY := (size.Height - trackHeight) / 2
-
-	r.track.Resize(fyne.NewSize(size.Width-padding*2, trackHeight))
-	r.track.Move(fyne.NewPos(padding, trackY))
+	geometry := r.slider.geometry(size)
+	fillRatio := valueRatio(r.slider.Scale, r.slider.Value, r.slider.Min, r.slider.Max)
 
-	fillRatio := (r.slider.Value - r.slider.Min) / (r.slider.Max - r.slider.Min)
-	if math.IsNaN(fillRatio) || math.IsInf(fillRatio, 0) {
-		fillRatio = 0
-	}
-	fillWidth := float32(fillRatio) * (size.Width - padding*2)
+	geometry.layoutTrack(r.track, trackThickness)
+	geometry.layoutSegment(r.fill, trackThickness, 0, fillRatio)
+	r.layoutTicks(geometry)
 
-	r.fill.Resize(fyne.NewSize(fillWidth, trackHeight))
-	r.fill.Move(fyne.NewPos(padding, trackY))
+	// При наведении ползунок плавно увеличивается; зона попадания остается прежней
+	hover := smoothstep(r.slider.thumbHoverLevel)
+	r.slider.thumbCenter = geometry.pointAt(fillRatio)
+	layoutThumb(r.thumb, r.slider.thumbCenter, r.slider.thumbSize*float32(1+0.2*hover))
+	r.layoutTooltip()
 
-	thumbX := padding + fillWidth
-	thumbY := size.Height / 2
-	r.slider.thumbCenter = fyne.NewPos(thumbX, thumbY)
+	// Рамка фокуса обводит весь виджет с небольшим отступом
+	inset := r.focusRing.StrokeWidth
+	r.focusRing.Resize(fyne.NewSize(size.Width-inset*2, size.Height-inset*2))
+	r.focusRing.Move(fyne.NewPos(inset, inset))
+}
 
-	r.thumb.Resize(fyne.NewSize(thumbSize, thumbSize))
-	r.thumb.Move(fyne.NewPos(thumbX-thumbSize/2, thumbY-thumbSize/2))
+// layoutThumb размещает круглый ползунок с центром в center
+func layoutThumb(thumb fyne.CanvasObject, center fyne.Position, thumbSize float32) {
+	thumb.Resize(fyne.NewSize(thumbSize, thumbSize))
+	thumb.Move(fyne.NewPos(center.X-thumbSize/2, center.Y-thumbSize/2))
 }
 
 func (r *neonSliderRenderer) MinSize() fyne.Size {
-	return fyne.NewSize(250, 80)
+	// Трек по центру, поэтому подписи делений и подсказка требуют места с обеих сторон
+	across := max(80, 2*r.tickExtent(), 2*r.tooltipExtent())
+	if r.slider.Orientation == Vertical {
+		return fyne.NewSize(across, 250)
+	}
+	return fyne.NewSize(250, across)
 }
 
-// УЛУЧШЕННЫЙ Refresh с более яркими и заметными эффектами
-func (r *neonSliderRenderer) Refresh() {
-	if r.track == nil || r.fill == nil || r.thumb == nil {
-		return
-	}
+// neonPalette - цвета и толщины свечения элементов слайдера на одном кадре
+type neonPalette struct {
+	trackFill, trackStroke color.Color
+	trackStrokeWidth       float32
 
-	colors := &r.slider.Colors
-	intensity := r.slider.glowIntensity
-	pulse := r.slider.pulsePhase
-	shimmer := r.slider.shimmerPhase
+	fillColor, fillStroke color.Color
+	fillStrokeWidth       float32
+
+	thumbCore, thumbGlow color.Color
+	thumbStrokeWidth     float32
+
+	focusStroke color.Color
+}
+
+// newNeonPalette рассчитывает УЛУЧШЕННУЮ палитру с более яркими и заметными эффектами
+func newNeonPalette(colors *NeonColors, intensity, pulse, shimmer float64) neonPalette {
+	var p neonPalette
 
 	if intensity < colors.MinIntensity {
 		intensity = colors.MinIntensity
@@ -634,20 +1356,20 @@ func (r *neonSliderRenderer) Refresh() {
 	// УСИЛЕННОЕ свечение дорожки
 	trackGlow := colors.MinIntensity*0.8 + intensity*0.2 // Больше базового свечения
 
-	r.track.FillColor = color.RGBA{
+	p.trackFill = color.RGBA{
 		R: colors.TrackR,
 		G: colors.TrackG,
 		B: colors.TrackB,
 		A: 255,
 	}
 
-	r.track.StrokeColor = color.RGBA{
+	p.trackStroke = color.RGBA{
 		R: uint8(float64(colors.PrimaryR) * trackGlow),
 		G: uint8(float64(colors.PrimaryG) * trackGlow),
 		B: uint8(float64(colors.PrimaryB) * trackGlow),
 		A: uint8(100 + trackGlow*155), // Увеличена базовая прозрачность
 	}
-	r.track.StrokeWidth = float32(2.0 + trackGlow*2.0) // Увеличена толщина
+	p.trackStrokeWidth = float32(2.0 + trackGlow*2.0) // Увеличена толщина
 
 	// МАКСИМАЛЬНО заметная заливка
 	fillBrightness := intensity + pulse*0.3 + shimmer*0.2 // УВЕЛИЧЕНЫ коэффициенты
@@ -660,7 +1382,7 @@ func (r *neonSliderRenderer) Refresh() {
 
 	fillAlpha := uint8(200 + fillBrightness*55) // Увеличена базовая непрозрачность
 
-	r.fill.FillColor = color.RGBA{
+	p.fillColor = color.RGBA{
 		R: uint8(math.Min(255, float64(colors.PrimaryR)*(0.7+fillBrightness*0.3))), // Увеличен диапазон
 		G: uint8(math.Min(255, float64(colors.PrimaryG)*(0.7+fillBrightness*0.3))),
 		B: uint8(math.Min(255, float64(colors.PrimaryB)*(0.7+fillBrightness*0.3))),
@@ -673,13 +1395,13 @@ func (r *neonSliderRenderer) Refresh() {
 		glowIntensity = colors.MaxIntensity
 	}
 
-	r.fill.StrokeColor = color.RGBA{
+	p.fillStroke = color.RGBA{
 		R: uint8(math.Min(255, float64(colors.PrimaryR)*(0.8+glowIntensity*0.5))), // Ярче
 		G: uint8(math.Min(255, float64(colors.PrimaryG)*(0.8+glowIntensity*0.5))),
 		B: uint8(math.Min(255, float64(colors.PrimaryB)*(0.8+glowIntensity*0.5))),
 		A: uint8(150 + glowIntensity*105), // Максимальная видимость
 	}
-	r.fill.StrokeWidth = colors.GlowRadius * float32(1.0+fillBrightness*0.8) // Увеличен радиус
+	p.fillStrokeWidth = colors.GlowRadius * float32(1.0+fillBrightness*0.8) // Увеличен радиус
 
 	// СУПЕР-ЯРКИЙ ползунок
 	thumbBrightness := fillBrightness + pulse*0.5 + shimmer*0.4 // МАКСИМУМ
@@ -691,7 +1413,7 @@ func (r *neonSliderRenderer) Refresh() {
 	}
 
 	// Ядро ползунка - максимально яркое
-	thumbCore := color.RGBA{
+	p.thumbCore = color.RGBA{
 		R: uint8(math.Min(255, float64(colors.PrimaryR)*(0.8+thumbBrightness*0.2))),
 		G: uint8(math.Min(255, float64(colors.PrimaryG)*(0.8+thumbBrightness*0.2))),
 		B: uint8(math.Min(255, float64(colors.PrimaryB)*(0.8+thumbBrightness*0.2))),
@@ -699,18 +1421,128 @@ func (r *neonSliderRenderer) Refresh() {
 	}
 
 	// Свечение ползунка - максимальная видимость
-	thumbGlow := color.RGBA{
+	p.thumbGlow = color.RGBA{
 		R: uint8(math.Min(255, float64(colors.PrimaryR)*(1.0+thumbBrightness*0.5))),
 		G: uint8(math.Min(255, float64(colors.PrimaryG)*(1.0+thumbBrightness*0.5))),
 		B: uint8(math.Min(255, float64(colors.PrimaryB)*(1.0+thumbBrightness*0.5))),
 		A: uint8(180 + thumbBrightness*75), // Очень высокая видимость
 	}
 
-	r.thumb.FillColor = thumbCore
-	r.thumb.StrokeColor = thumbGlow
-	r.thumb.StrokeWidth = colors.GlowRadius * float32(1.2+thumbBrightness*0.8) // Максимальное свечение
+	p.thumbStrokeWidth = colors.GlowRadius * float32(1.2+thumbBrightness*0.8) // Максимальное свечение
+
+	// Рамка фокуса мерцает вместе с заливкой
+	p.focusStroke = color.RGBA{
+		R: uint8(math.Min(255, float64(colors.PrimaryR)*(0.6+fillBrightness*0.4))),
+		G: uint8(math.Min(255, float64(colors.PrimaryG)*(0.6+fillBrightness*0.4))),
+		B: uint8(math.Min(255, float64(colors.PrimaryB)*(0.6+fillBrightness*0.4))),
+		A: uint8(120 + fillBrightness*100),
+	}
+
+	return p
+}
+
+// highlight усиливает свечение при наведении: thumb - ползунка, track - дорожки (0.0-1.0)
+func (p *neonPalette) highlight(thumb, track float64) {
+	white := color.RGBA{R: 255, G: 255, B: 255, A: 255}
+
+	p.thumbCore = mixColor(p.thumbCore, white, 0.35*thumb)
+	p.thumbStrokeWidth *= float32(1 + 0.4*thumb)
+
+	p.trackStroke = mixColor(p.trackStroke, p.fillStroke, 0.6*track)
+	p.trackStrokeWidth += float32(2 * track)
+}
+
+// mixColor линейно смешивает цвета a и b в пропорции t (0.0 - a, 1.0 - b)
+func mixColor(a, b color.Color, t float64) color.Color {
+	ca := color.RGBAModel.Convert(a).(color.RGBA)
+	cb := color.RGBAModel.Convert(b).(color.RGBA)
+	mix := func(x, y uint8) uint8 {
+		return uint8(float64(x) + (float64(y)-float64(x))*t)
+	}
+	return color.RGBA{R: mix(ca.R, cb.R), G: mix(ca.G, cb.G), B: mix(ca.B, cb.B), A: mix(ca.A, cb.A)}
+}
+
+// desaturate приглушает палитру для отключенного состояния:
+// цвета смещаются к серому, свечение становится тоньше и прозрачнее
+func (p *neonPalette) desaturate() {
+	p.trackStroke = desaturateColor(p.trackStroke, 0.5)
+	p.fillColor = desaturateColor(p.fillColor, 0.4)
+	p.fillStroke = desaturateColor(p.fillStroke, 0.25)
+	p.thumbCore = desaturateColor(p.thumbCore, 0.5)
+	p.thumbGlow = desaturateColor(p.thumbGlow, 0.25)
+	p.focusStroke = desaturateColor(p.focusStroke, 0.25)
+
+	p.trackStrokeWidth *= 0.5
+	p.fillStrokeWidth *= 0.3
+	p.thumbStrokeWidth *= 0.3
+}
+
+// desaturateColor смешивает цвет с серым (75%), затемняет его и умножает прозрачность на alpha
+func desaturateColor(c color.Color, alpha float64) color.Color {
+	rgba := color.RGBAModel.Convert(c).(color.RGBA)
+	gray := 0.299*float64(rgba.R) + 0.587*float64(rgba.G) + 0.114*float64(rgba.B)
+	mix := func(v uint8) uint8 {
+		return uint8((float64(v)*0.25 + gray*0.75) * 0.6)
+	}
+	return color.RGBA{R: mix(rgba.R), G: mix(rgba.G), B: mix(rgba.B), A: uint8(float64(rgba.A) * alpha)}
+}
+
+// applyTrack раскрашивает дорожку
+func (p *neonPalette) applyTrack(track *canvas.Rectangle) {
+	track.FillColor = p.trackFill
+	track.StrokeColor = p.trackStroke
+	track.StrokeWidth = p.trackStrokeWidth
+}
+
+// applyFill раскрашивает заливку
+func (p *neonPalette) applyFill(fill *canvas.Rectangle) {
+	fill.FillColor = p.fillColor
+	fill.StrokeColor = p.fillStroke
+	fill.StrokeWidth = p.fillStrokeWidth
+}
+
+// applyThumb раскрашивает ползунок
+func (p *neonPalette) applyThumb(thumb *canvas.Circle) {
+	thumb.FillColor = p.thumbCore
+	thumb.StrokeColor = p.thumbGlow
+	thumb.StrokeWidth = p.thumbStrokeWidth
+}
+
+// Refresh перерисовывает слайдер по текущему состоянию свечения
+func (r *neonSliderRenderer) Refresh() {
+	if r.track == nil || r.fill == nil || r.thumb == nil || r.focusRing == nil {
+		return
+	}
+
+	var palette neonPalette
+	if r.slider.Disabled() {
+		// Отключенный слайдер рисуется с минимальным свечением без эффектов
+		palette = newNeonPalette(&r.slider.Colors, r.slider.Colors.MinIntensity, 0, 0)
+		palette.desaturate()
+	} else {
+		palette = newNeonPalette(&r.slider.Colors,
+			r.slider.glowIntensity, r.slider.pulsePhase, r.slider.shimmerPhase)
+	}
+	palette.highlight(smoothstep(r.slider.thumbHoverLevel), smoothstep(r.slider.trackHoverLevel))
+	palette.applyTrack(r.track)
+	palette.applyFill(r.fill)
+	palette.applyThumb(r.thumb)
+
+	if r.slider.ticksStale {
+		r.updateTicks()
+	}
+	r.applyTicks(&palette, valueRatio(r.slider.Scale, r.slider.Value, r.slider.Min, r.slider.Max))
+	r.applyTooltip(&palette)
+
+	r.focusRing.StrokeColor = palette.focusStroke
+	if r.slider.focused {
+		r.focusRing.Show()
+	} else {
+		r.focusRing.Hide()
+	}
 
 	// Принудительное обновление
+	canvas.Refresh(r.focusRing)
 	canvas.Refresh(r.track)
 	canvas.Refresh(r.fill)
 	canvas.Refresh(r.thumb)
@@ -718,7 +1550,23 @@ func (r *neonSliderRenderer) Refresh() {
 }
 
 func (r *neonSliderRenderer) Objects() []fyne.CanvasObject {
-	return []fyne.CanvasObject{r.track, r.fill, r.thumb}
+	objects := make([]fyne.CanvasObject, 0, 6+len(r.tickLines)+len(r.tickLabels))
+	objects = append(objects, r.focusRing)
+	for _, line := range r.tickLines {
+		objects = append(objects, line)
+	}
+	for _, label := range r.tickLabels {
+		objects = append(objects, label)
+	}
+	return append(objects, r.track, r.fill, r.thumb, r.tooltip, r.tooltipText)
 }
 
-func (r *neonSliderRenderer) Destroy() {}
+// Destroy останавливает анимацию, чтобы удалённый слайдер не продолжал обновляться.
+// Устаревший рендерер, уже заменённый новым, анимацию не трогает.
+func (r *neonSliderRenderer) Destroy() {
+	if r.slider.renderer != r {
+		return
+	}
+	r.slider.renderer = nil
+	r.slider.StopAnimation()
+}
@@ -0,0 +1,375 @@
+package neonslider
+
+import (
+	"math"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/widget"
+)
+
+// rangeThumb обозначает ползунок диапазонного слайдера
+type rangeThumb int
+
+const (
+	thumbNone rangeThumb = iota
+	thumbLow
+	thumbHigh
+)
+
+// NeonRangeSlider представляет неоновый слайдер диапазона с двумя ползунками
+type NeonRangeSlider struct {
+	widget.BaseWidget
+	neonAnimation
+
+	// Основные параметры слайдера
+	Min, Max  float64                 // Минимальное и максимальное значения
+	Low, High float64                 // Нижняя и верхняя границы выбранного диапазона
+	Step      float64                 // Шаг изменения значений в пространстве шкалы (0 = без ограничений)
+	Scale     Scale                   // Шкала значений (nil = линейная)
+	MinGap    float64                 // Минимальное расстояние между Low и High (0 = ползунки могут совпадать)
+	OnChanged func(low, high float64) // Callback при изменении диапазона
+
+	// Состояние взаимодействия
+	activeThumb rangeThumb     // Перетаскиваемый ползунок
+	DragMode    SliderDragMode // Режим перетаскивания
+	Orientation Orientation    // Ориентация слайдера
+
+	// Визуальные настройки
+	Colors NeonColors // Цветовая схема
+
+	// Геометрия (внутренние параметры)
+	lowCenter, highCenter fyne.Position            // Центры ползунков
+	thumbSize             float32                  // Размер ползунков
+	renderer              *neonRangeSliderRenderer // Рендерер
+}
+
+// NewRange создает слайдер диапазона, выделяющий весь интервал от min до max
+func NewRange(min, max float64) *NeonRangeSlider {
+	return NewRangeWithColor(min, max, GreenCyber)
+}
+
+// NewRangeWithColor создает слайдер диапазона с указанной цветовой схемой
+func NewRangeWithColor(min, max float64, colors NeonColors) *NeonRangeSlider {
+	return NewRangeWithSettings(min, max, 0, colors, DragFullTrack, AnimationWave)
+}
+
+// NewRangeWithSettings создает слайдер диапазона с полной настройкой включая шаг
+func NewRangeWithSettings(min, max, step float64, colors NeonColors,
+	dragMode SliderDragMode, animType AnimationType) *NeonRangeSlider {
+	slider := &NeonRangeSlider{
+		Min:       min,
+		Max:       max,
+		Low:       min,
+		High:      max,
+		Step:      step,
+		DragMode:  dragMode,
+		Colors:    colors,
+		thumbSize: 32,
+	}
+	slider.neonAnimation = newNeonAnimation(slider, animType, colors)
+
+	slider.ExtendBaseWidget(slider)
+	return slider
+}
+
+// SetRange устанавливает обе границы диапазона с учетом шага и минимального зазора
+func (r *NeonRangeSlider) SetRange(low, high float64) {
+	if low > high {
+		low, high = high, low
+	}
+	low = snapValue(r.Scale, low, r.Min, r.Max, r.Step)
+	high = snapValue(r.Scale, high, r.Min, r.Max, r.Step)
+
+	// Раздвигаем границы до минимального зазора, не выходя за пределы диапазона
+	// и не покидая сетку шага
+	if gap := r.gap(); high-low < gap {
+		high = r.snapAway(low+gap, 1)
+		if high-low < gap {
+			low = r.snapAway(high-gap, -1)
+		}
+	}
+
+	r.applyRange(low, high)
+}
+
+// SetLow перемещает нижнюю границу, не позволяя ей подойти к верхней ближе MinGap
+func (r *NeonRangeSlider) SetLow(low float64) {
+	low = snapValue(r.Scale, low, r.Min, r.Max, r.Step)
+	if limit := r.High - r.gap(); low > limit {
+		low = r.snapAway(limit, -1)
+	}
+	r.applyRange(low, r.High)
+}
+
+// SetHigh перемещает верхнюю границу, не позволяя ей подойти к нижней ближе MinGap
+func (r *NeonRangeSlider) SetHigh(high float64) {
+	high = snapValue(r.Scale, high, r.Min, r.Max, r.Step)
+	if limit := r.Low + r.gap(); high < limit {
+		high = r.snapAway(limit, 1)
+	}
+	r.applyRange(r.Low, high)
+}
+
+// GetRange возвращает текущие границы диапазона
+func (r *NeonRangeSlider) GetRange() (low, high float64) {
+	return r.Low, r.High
+}
+
+// SetStep устанавливает шаг изменения значений и перепроверяет текущий диапазон
+func (r *NeonRangeSlider) SetStep(step float64) {
+	if step < 0 {
+		step = 0
+	}
+	r.Step = step
+	r.SetRange(r.Low, r.High)
+}
+
+// SetScale задает шкалу значений и перепроверяет текущий диапазон
+func (r *NeonRangeSlider) SetScale(scale Scale) {
+	r.Scale = scale
+	r.SetRange(r.Low, r.High)
+}
+
+// SetMinGap устанавливает минимальное расстояние между границами
+func (r *NeonRangeSlider) SetMinGap(gap float64) {
+	if gap < 0 {
+		gap = 0
+	}
+	r.MinGap = gap
+	r.SetRange(r.Low, r.High)
+}
+
+// SetColors изменяет цветовую схему слайдера
+func (r *NeonRangeSlider) SetColors(colors NeonColors) {
+	r.Colors = colors
+	r.glowIntensity = colors.MinIntensity
+	r.Refresh()
+}
+
+// SetDragMode изменяет режим перетаскивания
+func (r *NeonRangeSlider) SetDragMode(mode SliderDragMode) {
+	r.DragMode = mode
+}
+
+// SetOrientation изменяет ориентацию слайдера
+func (r *NeonRangeSlider) SetOrientation(orientation Orientation) {
+	if r.Orientation == orientation {
+		return
+	}
+	r.Orientation = orientation
+	r.Refresh()
+}
+
+// gap возвращает действующий минимальный зазор, не превышающий ширину диапазона
+func (r *NeonRangeSlider) gap() float64 {
+	return math.Max(0, math.Min(r.MinGap, r.Max-r.Min))
+}
+
+// snapAway округляет значение к шагу в сторону direction (> 0 - к Max, < 0 - к Min),
+// чтобы округление не сократило зазор между границами. Результат не выходит за диапазон.
+func (r *NeonRangeSlider) snapAway(value, direction float64) float64 {
+	snapped := snapValue(r.Scale, value, r.Min, r.Max, r.Step)
+	if r.Step <= 0 {
+		return snapped
+	}
+
+	// Допуск на ошибку округления для дробных шагов вроде 0.1
+	scale := scaleFor(r.Scale, r.Min, r.Max)
+	shift := (scale.ToScale(snapped) - scale.ToScale(value)) * direction
+	if shift >= -r.Step*1e-9 {
+		return snapped
+	}
+	return snapValue(r.Scale, offsetValue(r.Scale, snapped, math.Copysign(r.Step, direction), r.Min, r.Max),
+		r.Min, r.Max, r.Step)
+}
+
+// applyRange сохраняет границы и вызывает callback, если они изменились
+func (r *NeonRangeSlider) applyRange(low, high float64) {
+	if math.IsNaN(low) || math.IsNaN(high) {
+		return // Пользовательская шкала не определена для этих значений
+	}
+	if r.Low != low || r.High != high {
+		r.Low, r.High = low, high
+		if r.OnChanged != nil {
+			r.OnChanged(low, high)
+		}
+	}
+
+	r.Refresh()
+}
+
+// StepAnimation рассчитывает один кадр анимации по текущему времени часов
+func (r *NeonRangeSlider) StepAnimation() {
+	now, _ := r.tick()
+	r.updateGlow(now, &r.Colors, r.activeThumb != thumbNone)
+}
+
+// redraw перерисовывает слайдер на кадре анимации
+func (r *NeonRangeSlider) redraw() {
+	r.Refresh()
+}
+
+// geometry возвращает геометрию трека для текущего размера виджета
+func (r *NeonRangeSlider) geometry(size fyne.Size) trackGeometry {
+	return trackGeometry{size: size, thumbSize: r.thumbSize, orientation: r.Orientation}
+}
+
+// thumbAt выбирает ползунок для позиции указателя.
+// В режиме DragThumbOnly учитываются только попадания в ползунок, иначе выбирается ближайший.
+// Если ползунки совпадают, direction (> 0 - к Max, < 0 - к Min) определяет, какой из них сдвинуть.
+func (r *NeonRangeSlider) thumbAt(pos fyne.Position, direction float64) rangeThumb {
+	inLow := pointInThumb(pos, r.lowCenter, r.thumbSize)
+	inHigh := pointInThumb(pos, r.highCenter, r.thumbSize)
+	if r.DragMode == DragThumbOnly && !inLow && !inHigh {
+		return thumbNone
+	}
+
+	lowDistance := r.axisDistance(pos, r.lowCenter)
+	highDistance := r.axisDistance(pos, r.highCenter)
+	switch {
+	case lowDistance < highDistance:
+		return thumbLow
+	case highDistance < lowDistance:
+		return thumbHigh
+	case direction < 0:
+		return thumbLow
+	case direction > 0:
+		return thumbHigh
+	}
+
+	// Ползунки совпадают, направление неизвестно - двигаем тот, у которого есть свобода
+	if r.High >= r.Max {
+		return thumbLow
+	}
+	return thumbHigh
+}
+
+// axisDistance возвращает расстояние между точками вдоль оси слайдера
+func (r *NeonRangeSlider) axisDistance(a, b fyne.Position) float32 {
+	if r.Orientation == Vertical {
+		return float32(math.Abs(float64(a.Y - b.Y)))
+	}
+	return float32(math.Abs(float64(a.X - b.X)))
+}
+
+// moveThumbTo перемещает ползунок в позицию указателя
+func (r *NeonRangeSlider) moveThumbTo(thumb rangeThumb, pos fyne.Position) {
+	ratio, ok := r.geometry(r.Size()).ratioAt(pos)
+	if !ok {
+		return
+	}
+	value := ratioValue(r.Scale, ratio, r.Min, r.Max)
+
+	switch thumb {
+	case thumbLow:
+		r.SetLow(value)
+	case thumbHigh:
+		r.SetHigh(value)
+	}
+}
+
+// Реализация интерфейсов взаимодействия
+func (r *NeonRangeSlider) Tapped(e *fyne.PointEvent) {
+	r.moveThumbTo(r.thumbAt(e.Position, 0), e.Position)
+}
+
+func (r *NeonRangeSlider) Dragged(e *fyne.DragEvent) {
+	if r.activeThumb == thumbNone {
+		startPos := fyne.NewPos(e.Position.X-e.Dragged.DX, e.Position.Y-e.Dragged.DY)
+
+		// Направление движения в значениях: для вертикального слайдера ось Y перевернута
+		direction := float64(e.Dragged.DX)
+		if r.Orientation == Vertical {
+			direction = float64(-e.Dragged.DY)
+		}
+		r.activeThumb = r.thumbAt(startPos, direction)
+	}
+
+	if r.activeThumb != thumbNone {
+		r.moveThumbTo(r.activeThumb, e.Position)
+	}
+}
+
+func (r *NeonRangeSlider) DragEnd() {
+	r.activeThumb = thumbNone
+}
+
+// CreateRenderer создает рендерер для слайдера диапазона
+func (r *NeonRangeSlider) CreateRenderer() fyne.WidgetRenderer {
+	renderer := &neonRangeSliderRenderer{
+		slider:    r,
+		track:     canvas.NewRectangle(nil),
+		fill:      canvas.NewRectangle(nil),
+		lowThumb:  canvas.NewCircle(nil),
+		highThumb: canvas.NewCircle(nil),
+	}
+	renderer.track.CornerRadius = 10
+	renderer.fill.CornerRadius = 10
+
+	// Fyne может пересоздавать рендерер; StartAnimation идемпотентен
+	r.renderer = renderer
+	renderer.Refresh()
+	r.StartAnimation()
+
+	return renderer
+}
+
+// neonRangeSliderRenderer отвечает за отрисовку слайдера диапазона
+type neonRangeSliderRenderer struct {
+	slider    *NeonRangeSlider
+	track     *canvas.Rectangle
+	fill      *canvas.Rectangle
+	lowThumb  *canvas.Circle
+	highThumb *canvas.Circle
+}
+
+func (r *neonRangeSliderRenderer) Layout(size fyne.Size) {
+	geometry := r.slider.geometry(size)
+	lowRatio := valueRatio(r.slider.Scale, r.slider.Low, r.slider.Min, r.slider.Max)
+	highRatio := valueRatio(r.slider.Scale, r.slider.High, r.slider.Min, r.slider.Max)
+
+	geometry.layoutTrack(r.track, trackThickness)
+	geometry.layoutSegment(r.fill, trackThickness, lowRatio, highRatio)
+
+	r.slider.lowCenter = geometry.pointAt(lowRatio)
+	r.slider.highCenter = geometry.pointAt(highRatio)
+	layoutThumb(r.lowThumb, r.slider.lowCenter, r.slider.thumbSize)
+	layoutThumb(r.highThumb, r.slider.highCenter, r.slider.thumbSize)
+}
+
+func (r *neonRangeSliderRenderer) MinSize() fyne.Size {
+	if r.slider.Orientation == Vertical {
+		return fyne.NewSize(80, 250)
+	}
+	return fyne.NewSize(250, 80)
+}
+
+// Refresh перерисовывает слайдер по текущему состоянию свечения
+func (r *neonRangeSliderRenderer) Refresh() {
+	palette := newNeonPalette(&r.slider.Colors,
+		r.slider.glowIntensity, r.slider.pulsePhase, r.slider.shimmerPhase)
+	palette.applyTrack(r.track)
+	palette.applyFill(r.fill)
+	palette.applyThumb(r.lowThumb)
+	palette.applyThumb(r.highThumb)
+
+	canvas.Refresh(r.track)
+	canvas.Refresh(r.fill)
+	canvas.Refresh(r.lowThumb)
+	canvas.Refresh(r.highThumb)
+	r.Layout(r.slider.Size())
+}
+
+func (r *neonRangeSliderRenderer) Objects() []fyne.CanvasObject {
+	return []fyne.CanvasObject{r.track, r.fill, r.lowThumb, r.highThumb}
+}
+
+// Destroy останавливает анимацию, если рендерер не был заменён новым
+func (r *neonRangeSliderRenderer) Destroy() {
+	if r.slider.renderer != r {
+		return
+	}
+	r.slider.renderer = nil
+	r.slider.StopAnimation()
+}
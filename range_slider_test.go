@@ -0,0 +1,84 @@
+package neonslider
+
+import (
+	"testing"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/test"
+)
+
+// newTestRange создает отрисованный слайдер диапазона 0-100 с шагом step и минимальным
+// зазором gap; рендерер уничтожается в конце теста
+func newTestRange(t *testing.T, step, gap float64) *NeonRangeSlider {
+	t.Helper()
+	r := NewRangeWithSettings(0, 100, step, GreenCyber, DragFullTrack, AnimationWave)
+	test.TempWidgetRenderer(t, r)
+	r.SetMinGap(gap)
+	return r
+}
+
+func TestRangeGapStaysOnStepGrid(t *testing.T) {
+	tests := []struct {
+		name      string
+		step, gap float64
+		set       func(r *NeonRangeSlider)
+		low, high float64
+	}{
+		{"range pushes high up", 10, 15, func(r *NeonRangeSlider) { r.SetRange(50, 50) }, 50, 70},
+		{"range at Max pushes low down", 10, 15, func(r *NeonRangeSlider) { r.SetRange(100, 100) }, 80, 100},
+		{"range swaps bounds", 10, 0, func(r *NeonRangeSlider) { r.SetRange(70, 20) }, 20, 70},
+		{"low stops below high", 10, 15, func(r *NeonRangeSlider) { r.SetRange(0, 60); r.SetLow(55) }, 40, 60},
+		{"high stops above low", 10, 15, func(r *NeonRangeSlider) { r.SetRange(30, 100); r.SetHigh(35) }, 30, 50},
+		{"gap on grid", 5, 15, func(r *NeonRangeSlider) { r.SetRange(50, 50) }, 50, 65},
+		{"no step", 0, 15, func(r *NeonRangeSlider) { r.SetRange(50, 50) }, 50, 65},
+		{"fractional step", 0.1, 0.25, func(r *NeonRangeSlider) { r.SetRange(0.5, 0.5) }, 0.5, 0.8},
+		{"gap wider than range", 10, 150, func(r *NeonRangeSlider) { r.SetRange(40, 60) }, 0, 100},
+	}
+
+	for _, tt := range tests {
+		r := newTestRange(t, tt.step, tt.gap)
+		tt.set(r)
+		if low, high := r.GetRange(); low != tt.low || high != tt.high {
+			t.Errorf("%s: got (%v, %v), want (%v, %v)", tt.name, low, high, tt.low, tt.high)
+		}
+	}
+}
+
+func TestRangeThumbSelection(t *testing.T) {
+	r := newTestRange(t, 10, 0)
+	r.SetRange(30, 70)
+	r.lowCenter, r.highCenter = fyne.NewPos(100, 40), fyne.NewPos(200, 40)
+
+	tests := []struct {
+		name      string
+		mode      SliderDragMode
+		x         float32
+		direction float64
+		want      rangeThumb
+	}{
+		{"near low", DragFullTrack, 120, 0, thumbLow},
+		{"near high", DragFullTrack, 170, 0, thumbHigh},
+		{"far left on track", DragFullTrack, 10, 0, thumbLow},
+		{"track in thumb-only mode", DragThumbOnly, 150, 0, thumbNone},
+		{"on high thumb in thumb-only mode", DragThumbOnly, 205, 0, thumbHigh},
+	}
+
+	for _, tt := range tests {
+		r.DragMode = tt.mode
+		if got := r.thumbAt(fyne.NewPos(tt.x, 40), tt.direction); got != tt.want {
+			t.Errorf("%s: got %v, want %v", tt.name, got, tt.want)
+		}
+	}
+
+	// Совпавшие ползунки выбираются по направлению движения
+	r.DragMode = DragFullTrack
+	r.lowCenter = r.highCenter
+	for _, tt := range []struct {
+		direction float64
+		want      rangeThumb
+	}{{-1, thumbLow}, {1, thumbHigh}} {
+		if got := r.thumbAt(r.highCenter, tt.direction); got != tt.want {
+			t.Errorf("stacked thumbs, direction %v: got %v, want %v", tt.direction, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,179 @@
+package neonslider
+
+import (
+	"math"
+	"sort"
+)
+
+// Scale задает шкалу слайдера - перевод значения в пространство шкалы и обратно.
+// Положение на треке линейно в пространстве шкалы: логарифмическая шкала отводит
+// одинаковую длину трека каждой декаде. Шаг Step, стрелки и прокрутка тоже работают
+// в пространстве шкалы. Функции должны быть строго возрастающими на [Min, Max].
+type Scale interface {
+	ToScale(value float64) float64
+	FromScale(position float64) float64
+}
+
+// LinearScale - линейная шкала (по умолчанию)
+type LinearScale struct{}
+
+// ToScale возвращает значение без изменений
+func (LinearScale) ToScale(value float64) float64 { return value }
+
+// FromScale возвращает положение без изменений
+func (LinearScale) FromScale(position float64) float64 { return position }
+
+// LogScale - логарифмическая шкала для частот, усиления и масштаба.
+// Min и Max слайдера должны быть больше нуля. Шаг задается в степенях Base:
+// Step = 0.1 при Base = 10 дает десять делений на декаду.
+type LogScale struct {
+	Base float64 // Основание логарифма (0 = 10)
+}
+
+// ToScale возвращает логарифм значения
+func (s LogScale) ToScale(value float64) float64 {
+	return math.Log(value) / math.Log(s.base())
+}
+
+// FromScale возводит основание в степень position
+func (s LogScale) FromScale(position float64) float64 {
+	return math.Pow(s.base(), position)
+}
+
+func (s LogScale) base() float64 {
+	if s.Base <= 0 || s.Base == 1 {
+		return 10
+	}
+	return s.Base
+}
+
+// ExpScale - экспоненциальная шкала на диапазоне слайдера [Min, Max]. Положение на треке
+// равно (exp(Rate*t)-1)/(exp(Rate)-1), где t = (value-Min)/(Max-Min): Rate > 0 дает
+// точность у Max, Rate < 0 - у Min. Пространство шкалы растянуто обратно на [Min, Max],
+// поэтому Step задается в единицах значения, но отсчитывается по треку: Step = 1 при
+// диапазоне 0-100 дает сто равных по длине делений. При Rate = 0 шкала линейна.
+type ExpScale struct {
+	Rate float64 // Крутизна шкалы, обычно от -5 до 5
+
+	min, max float64 // Диапазон слайдера, задается при использовании шкалы
+}
+
+// ToScale переводит значение в положение на треке, растянутое на [Min, Max]
+func (s ExpScale) ToScale(value float64) float64 {
+	if !s.curved() {
+		return value
+	}
+	t := (value - s.min) / (s.max - s.min)
+	return s.min + math.Expm1(s.Rate*t)/math.Expm1(s.Rate)*(s.max-s.min)
+}
+
+// FromScale выполняет обратное преобразование
+func (s ExpScale) FromScale(position float64) float64 {
+	if !s.curved() {
+		return position
+	}
+	// За пределами диапазона логарифм не определен, а слайдер все равно ограничит значение
+	p := max(0, min((position-s.min)/(s.max-s.min), 1))
+	return s.min + math.Log1p(p*math.Expm1(s.Rate))/s.Rate*(s.max-s.min)
+}
+
+// curved сообщает, отличается ли шкала от линейной
+func (s ExpScale) curved() bool {
+	return s.Rate != 0 && s.max > s.min
+}
+
+// PowerScale - степенная шкала: Exponent > 1 растягивает начало диапазона,
+// Exponent < 1 - конец. Отрицательные значения отражаются симметрично нулю.
+type PowerScale struct {
+	Exponent float64 // Показатель степени (0 = 1, линейная шкала)
+}
+
+// ToScale возводит модуль значения в степень Exponent с сохранением знака
+func (s PowerScale) ToScale(value float64) float64 {
+	return math.Copysign(math.Pow(math.Abs(value), 1/s.exponent()), value)
+}
+
+// FromScale выполняет обратное преобразование
+func (s PowerScale) FromScale(position float64) float64 {
+	return math.Copysign(math.Pow(math.Abs(position), s.exponent()), position)
+}
+
+func (s PowerScale) exponent() float64 {
+	if s.Exponent <= 0 {
+		return 1
+	}
+	return s.Exponent
+}
+
+// PiecewiseScale - кусочно-линейная шкала: значения Values делят трек на равные
+// отрезки, внутри отрезка шкала линейна. Например, {0, 100, 1000, 10000} отводит
+// по трети трека диапазонам 0-100, 100-1000 и 1000-10000. Values должны возрастать.
+type PiecewiseScale struct {
+	Values []float64 // Значения на границах отрезков
+}
+
+// ToScale возвращает дробный номер отрезка для значения
+func (s PiecewiseScale) ToScale(value float64) float64 {
+	n := len(s.Values)
+	if n < 2 {
+		return value
+	}
+	i := sort.SearchFloat64s(s.Values, value) - 1
+	i = max(0, min(i, n-2))
+	from, to := s.Values[i], s.Values[i+1]
+	return float64(i) + (value-from)/(to-from)
+}
+
+// FromScale возвращает значение для дробного номера отрезка
+func (s PiecewiseScale) FromScale(position float64) float64 {
+	n := len(s.Values)
+	if n < 2 {
+		return position
+	}
+	i := max(0, min(int(math.Floor(position)), n-2))
+	from, to := s.Values[i], s.Values[i+1]
+	return from + (position-float64(i))*(to-from)
+}
+
+// scaleFor возвращает шкалу, готовую к работе на диапазоне [min, max]: ExpScale получает
+// диапазон, а отсутствующая шкала или шкала, не определенная на границах диапазона
+// (например, LogScale при min <= 0), заменяется линейной
+func scaleFor(scale Scale, min, max float64) Scale {
+	switch s := scale.(type) {
+	case nil:
+		return LinearScale{}
+	case ExpScale:
+		s.min, s.max = min, max
+		return s
+	}
+
+	low, high := scaledRange(scale, min, max)
+	if math.IsNaN(low) || math.IsNaN(high) || math.IsInf(low, 0) || math.IsInf(high, 0) {
+		return LinearScale{}
+	}
+	return scale
+}
+
+// scaledRange переводит границы диапазона в пространство шкалы
+func scaledRange(scale Scale, min, max float64) (float64, float64) {
+	return scale.ToScale(min), scale.ToScale(max)
+}
+
+// ratioValue переводит положение на треке (0.0-1.0) в значение
+func ratioValue(scale Scale, ratio, min, max float64) float64 {
+	scale = scaleFor(scale, min, max)
+	low, high := scaledRange(scale, min, max)
+	switch ratio {
+	case 0:
+		return min
+	case 1:
+		return max
+	}
+	return scale.FromScale(low + ratio*(high-low))
+}
+
+// offsetValue сдвигает значение на delta в пространстве шкалы диапазона [min, max]
+func offsetValue(scale Scale, value, delta, min, max float64) float64 {
+	scale = scaleFor(scale, min, max)
+	return scale.FromScale(scale.ToScale(value) + delta)
+}
@@ -0,0 +1,81 @@
+package neonslider
+
+import (
+	"math"
+	"testing"
+)
+
+func TestExpScaleSpansRange(t *testing.T) {
+	tests := []struct {
+		min, max, rate float64
+	}{
+		{10, 400, 3},
+		{10, 400, -3},
+		{0, 1e6, 5},
+		{-50, 50, 0.5},
+	}
+
+	for _, tt := range tests {
+		scale := ExpScale{Rate: tt.rate}
+		low, high := scaledRange(scaleFor(scale, tt.min, tt.max), tt.min, tt.max)
+		if math.Abs(low-tt.min) > 1e-9 || math.Abs(high-tt.max) > 1e-6 {
+			t.Errorf("%+v: scaled range (%v, %v), want (%v, %v)", tt, low, high, tt.min, tt.max)
+		}
+
+		for _, ratio := range []float64{0.1, 0.25, 0.5, 0.9} {
+			value := ratioValue(scale, ratio, tt.min, tt.max)
+			if math.IsNaN(value) || value <= tt.min || value >= tt.max {
+				t.Errorf("%+v: ratio %v gives %v outside the range", tt, ratio, value)
+				continue
+			}
+			if got := valueRatio(scale, value, tt.min, tt.max); math.Abs(got-ratio) > 1e-9 {
+				t.Errorf("%+v: round trip of ratio %v gives %v", tt, ratio, got)
+			}
+		}
+	}
+}
+
+func TestExpScaleStepsAlongTrack(t *testing.T) {
+	slider := NewWithStep(10, 400, 39)
+	renderSlider(t, slider)
+	slider.SetScale(ExpScale{Rate: 3})
+
+	// Десять делений равной длины: значение растет все медленнее к Max
+	var previous, previousGap float64 = 10, math.Inf(1)
+	for i := 1; i <= 10; i++ {
+		slider.stepBy(1)
+		gap := slider.Value - previous
+		if gap <= 0 || gap >= previousGap {
+			t.Fatalf("step %d: value %v, gap %v after %v", i, slider.Value, gap, previousGap)
+		}
+		previous, previousGap = slider.Value, gap
+	}
+	if math.Abs(slider.Value-400) > 1e-9 {
+		t.Errorf("after ten steps: got %v, want 400", slider.Value)
+	}
+}
+
+func TestScaleUndefinedOnRangeFallsBackToLinear(t *testing.T) {
+	var changed []float64
+	slider := NewWithStep(0, 100, 1)
+	renderSlider(t, slider)
+	slider.SetValue(30)
+	slider.OnChanged = func(value float64) { changed = append(changed, value) }
+
+	slider.SetScale(LogScale{})
+	if slider.Value != 30 {
+		t.Errorf("value after LogScale on 0-100: got %v, want 30", slider.Value)
+	}
+	slider.SetValue(50)
+	if slider.Value != 50 {
+		t.Errorf("SetValue(50): got %v, want 50", slider.Value)
+	}
+	if got := ratioValue(slider.Scale, 0.25, slider.Min, slider.Max); got != 25 {
+		t.Errorf("track position 0.25: got %v, want linear 25", got)
+	}
+	for _, value := range changed {
+		if math.IsNaN(value) {
+			t.Fatal("OnChanged received NaN")
+		}
+	}
+}
@@ -0,0 +1,6 @@
+//go:build darwin
+
+package neonslider
+
+// scrollNotch - величина прокрутки за один щелчок колеса; на macOS Fyne умножает смещение на 10
+const scrollNotch = float32(10)
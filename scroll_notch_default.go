@@ -0,0 +1,6 @@
+//go:build !darwin && !wasm && !test_web_driver
+
+package neonslider
+
+// scrollNotch - величина прокрутки, которую драйвер Fyne сообщает за один щелчок колеса мыши
+const scrollNotch = float32(25)
@@ -0,0 +1,6 @@
+//go:build wasm || test_web_driver
+
+package neonslider
+
+// scrollNotch - величина прокрутки за один щелчок колеса; в браузере Fyne умножает смещение на 0.2
+const scrollNotch = float32(0.2)
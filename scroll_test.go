@@ -0,0 +1,51 @@
+package neonslider
+
+import (
+	"testing"
+
+	"fyne.io/fyne/v2"
+)
+
+// scrollBy прокручивает наведенный слайдер на dy
+func scrollBy(slider *NeonSlider, dy float32) {
+	slider.ScrollActivation = ScrollOnHover
+	slider.hovered = true
+	slider.Scrolled(&fyne.ScrollEvent{Scrolled: fyne.Delta{DY: dy}})
+}
+
+func TestScrollMovesOneStepPerNotch(t *testing.T) {
+	tests := []struct {
+		name   string
+		deltas []float32
+		want   float64
+	}{
+		{"one notch", []float32{scrollNotch}, 51},
+		{"accelerated notch", []float32{scrollNotch * 125}, 51},
+		{"notch down", []float32{-scrollNotch}, 49},
+		{"trackpad below notch", []float32{scrollNotch / 4, scrollNotch / 4}, 50},
+		{"trackpad reaches notch", []float32{scrollNotch / 2, scrollNotch / 2}, 51},
+	}
+
+	for _, tt := range tests {
+		slider := NewWithStep(0, 100, 1)
+		renderSlider(t, slider)
+		slider.SetValue(50)
+		for _, dy := range tt.deltas {
+			scrollBy(slider, dy)
+		}
+		if slider.Value != tt.want {
+			t.Errorf("%s: got %v, want %v", tt.name, slider.Value, tt.want)
+		}
+	}
+}
+
+func TestScrollDisabledByDefault(t *testing.T) {
+	slider := NewWithStep(0, 100, 1)
+	renderSlider(t, slider)
+	slider.SetValue(50)
+	slider.hovered = true
+	slider.Scrolled(&fyne.ScrollEvent{Scrolled: fyne.Delta{DY: scrollNotch}})
+	if slider.Value != 50 {
+		t.Errorf("default slider scrolled to %v, want 50", slider.Value)
+	}
+}
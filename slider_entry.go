@@ -0,0 +1,149 @@
+package neonslider
+
+import (
+	"fmt"
+	"image/color"
+	"math"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/layout"
+	"fyne.io/fyne/v2/widget"
+)
+
+// entryWidth - ширина поля ввода рядом со слайдером
+const entryWidth = float32(96)
+
+// NeonSliderWithEntry объединяет неоновый слайдер и поле ввода значения.
+// Поле и слайдер синхронизированы в обе стороны: движение слайдера обновляет текст,
+// а введенное число после Enter проверяется по диапазону и округляется к шагу.
+type NeonSliderWithEntry struct {
+	widget.BaseWidget
+
+	Slider *NeonSlider   // Управляемый слайдер; его Formatter задает текст поля и разбор ввода
+	Entry  *widget.Entry // Поле ввода значения
+}
+
+// NewWithEntry создает слайдер с полем ввода и базовыми настройками
+func NewWithEntry(min, max float64) *NeonSliderWithEntry {
+	return NewSliderWithEntry(New(min, max))
+}
+
+// NewSliderWithEntry добавляет поле ввода к существующему слайдеру
+func NewSliderWithEntry(slider *NeonSlider) *NeonSliderWithEntry {
+	w := &NeonSliderWithEntry{
+		Slider: slider,
+		Entry:  widget.NewEntry(),
+	}
+
+	w.Entry.Validator = w.validate
+	w.Entry.OnSubmitted = w.submit
+	slider.valueListener = w.updateText
+	w.updateText(slider.Value)
+
+	w.ExtendBaseWidget(w)
+	return w
+}
+
+// Enable включает слайдер и поле ввода
+func (w *NeonSliderWithEntry) Enable() {
+	w.Slider.Enable()
+	w.Entry.Enable()
+	w.Refresh()
+}
+
+// Disable отключает слайдер и поле ввода
+func (w *NeonSliderWithEntry) Disable() {
+	w.Slider.Disable()
+	w.Entry.Disable()
+	w.Refresh()
+}
+
+// Disabled сообщает, отключен ли виджет
+func (w *NeonSliderWithEntry) Disabled() bool {
+	return w.Slider.Disabled()
+}
+
+// updateText показывает значение слайдера в поле ввода
+func (w *NeonSliderWithEntry) updateText(value float64) {
+	w.Entry.SetText(w.Slider.FormatValue(value))
+}
+
+// validate проверяет, что текст разбирается в конечное число из диапазона слайдера.
+// Кратность шагу не требуется: значение округляется при вводе.
+func (w *NeonSliderWithEntry) validate(text string) error {
+	value, err := w.Slider.parseValue(text)
+	if err != nil {
+		return err
+	}
+	// Пользовательский Formatter может вернуть NaN, который не отсекается проверкой диапазона
+	if math.IsNaN(value) || math.IsInf(value, 0) {
+		return fmt.Errorf("%q is not a finite number", text)
+	}
+	if value < w.Slider.Min || value > w.Slider.Max {
+		return fmt.Errorf("value must be between %s and %s",
+			w.Slider.FormatValue(w.Slider.Min), w.Slider.FormatValue(w.Slider.Max))
+	}
+	return nil
+}
+
+// submit переносит введенное значение в слайдер
+func (w *NeonSliderWithEntry) submit(text string) {
+	if w.validate(text) != nil || !w.Slider.interactive() {
+		return
+	}
+	value, _ := w.Slider.parseValue(text)
+	w.Slider.changeValue(value)
+	w.Slider.fireChangeEnded()
+
+	// Показываем значение после округления к шагу, даже если оно не изменилось
+	w.updateText(w.Slider.Value)
+}
+
+// CreateRenderer создает рендерер: слайдер и поле ввода в неоновой рамке
+func (w *NeonSliderWithEntry) CreateRenderer() fyne.WidgetRenderer {
+	frame := canvas.NewRectangle(color.Transparent)
+	frame.CornerRadius = 6
+	frame.StrokeWidth = 2
+
+	entryBox := container.New(
+		layout.NewGridWrapLayout(fyne.NewSize(entryWidth, w.Entry.MinSize().Height)),
+		container.NewStack(w.Entry, frame),
+	)
+
+	var content *fyne.Container
+	if w.Slider.Orientation == Vertical {
+		content = container.NewBorder(nil, container.NewCenter(entryBox), nil, nil, w.Slider)
+	} else {
+		content = container.NewBorder(nil, nil, nil, container.NewCenter(entryBox), w.Slider)
+	}
+
+	r := &neonSliderWithEntryRenderer{
+		WidgetRenderer: widget.NewSimpleRenderer(content),
+		widget:         w,
+		frame:          frame,
+	}
+	r.Refresh()
+	return r
+}
+
+// neonSliderWithEntryRenderer дополняет простой рендерер раскраской рамки поля ввода
+type neonSliderWithEntryRenderer struct {
+	fyne.WidgetRenderer
+	widget *NeonSliderWithEntry
+	frame  *canvas.Rectangle
+}
+
+// Refresh окрашивает рамку поля в цвет слайдера
+func (r *neonSliderWithEntryRenderer) Refresh() {
+	slider := r.widget.Slider
+	palette := newNeonPalette(&slider.Colors, slider.Colors.MaxIntensity, 0, 0)
+	if slider.Disabled() {
+		palette.desaturate()
+	}
+	r.frame.StrokeColor = palette.focusStroke
+	r.frame.Refresh()
+
+	r.WidgetRenderer.Refresh()
+}
@@ -0,0 +1,43 @@
+package neonslider
+
+import (
+	"math"
+	"testing"
+)
+
+func TestEntryRejectsNonFiniteValues(t *testing.T) {
+	w := NewWithEntry(0, 100)
+	renderSlider(t, w.Slider)
+	for _, text := range []string{"NaN", "Inf", "-Inf"} {
+		if err := w.validate(text); err == nil {
+			t.Errorf("validate(%q) accepted a non-finite value", text)
+		}
+	}
+
+	// Пользовательский Formatter, возвращающий NaN, тоже не проходит проверку
+	w.Slider.SetFormatter(nanFormatter{})
+	if err := w.validate("anything"); err == nil {
+		t.Error("validate accepted NaN from a custom Formatter")
+	}
+}
+
+func TestEntryFollowsSliderFormatter(t *testing.T) {
+	w := NewWithEntry(0, 1)
+	renderSlider(t, w.Slider)
+	w.Slider.SetValue(0.25)
+	w.Slider.SetFormatter(PercentFormatter{Ratio: true})
+	if w.Entry.Text != "25%" {
+		t.Errorf("entry text: got %q, want %q", w.Entry.Text, "25%")
+	}
+
+	w.submit("40%")
+	if w.Slider.Value != 0.4 {
+		t.Errorf("submitted 40%%: got %v, want 0.4", w.Slider.Value)
+	}
+}
+
+// nanFormatter разбирает любой текст в NaN
+type nanFormatter struct{}
+
+func (nanFormatter) Format(float64) string         { return "" }
+func (nanFormatter) Parse(string) (float64, error) { return math.NaN(), nil }
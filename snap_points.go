@@ -0,0 +1,69 @@
+package neonslider
+
+import (
+	"math"
+	"slices"
+)
+
+// DefaultSnapRadius - радиус притяжения точек привязки по умолчанию, в пикселях
+const DefaultSnapRadius = float32(8)
+
+// snapFlashLevel - сила вспышки свечения, когда ползунок захвачен точкой привязки
+const snapFlashLevel = 0.6
+
+// SetSnapPoints задает точки мягкой привязки: при перетаскивании или нажатии ближе
+// radius пикселей ползунок притягивается к точке и вспыхивает. radius <= 0 означает
+// DefaultSnapRadius. Точки работают и без шага; при Step > 0 точка может лежать
+// вне сетки шага - захваченное значение не округляется.
+func (n *NeonSlider) SetSnapPoints(radius float32, points ...float64) {
+	n.SnapPoints = points
+	n.SnapRadius = radius
+}
+
+// isSnapPoint сообщает, совпадает ли значение с точкой привязки внутри диапазона
+func (n *NeonSlider) isSnapPoint(value float64) bool {
+	if value < n.Min || value > n.Max {
+		return false
+	}
+	return slices.Contains(n.SnapPoints, value)
+}
+
+// attract притягивает значение к ближайшей точке привязки в пределах SnapRadius
+// пикселей трека. Возвращает итоговое значение и признак захвата.
+func (n *NeonSlider) attract(value float64) (float64, bool) {
+	length := float64(n.geometry(n.Size()).length())
+	if len(n.SnapPoints) == 0 || length <= 0 {
+		return value, false
+	}
+
+	radius := float64(n.SnapRadius)
+	if radius <= 0 {
+		radius = float64(DefaultSnapRadius)
+	}
+
+	ratio := valueRatio(n.Scale, value, n.Min, n.Max)
+	best, bestDistance := value, radius
+	snapped := false
+	for _, point := range n.SnapPoints {
+		if point < n.Min || point > n.Max {
+			continue
+		}
+		distance := math.Abs(valueRatio(n.Scale, point, n.Min, n.Max)-ratio) * length
+		if distance <= bestDistance {
+			best, bestDistance, snapped = point, distance, true
+		}
+	}
+	return best, snapped
+}
+
+// changeValueAttracted устанавливает значение с учетом точек привязки.
+// При захвате точки свечение вспыхивает один раз, пока ползунок не покинет её.
+func (n *NeonSlider) changeValueAttracted(value float64) {
+	value, snapped := n.attract(value)
+	n.changeValue(value)
+
+	if snapped && !n.snapped {
+		n.flashLevel = math.Max(n.flashLevel, snapFlashLevel)
+	}
+	n.snapped = snapped
+}
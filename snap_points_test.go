@@ -0,0 +1,72 @@
+package neonslider
+
+import (
+	"testing"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/test"
+)
+
+func TestSnapPointsCaptureAndRelease(t *testing.T) {
+	test.NewApp()
+	slider := NewWithStep(0, 100, 5)
+	slider.Resize(fyne.NewSize(232, 40)) // Длина трека 200 px: 1 px = 0.5 единицы
+	renderSlider(t, slider)
+	slider.SetSnapPoints(8, 33) // Точка вне сетки шага 5
+
+	geometry := slider.geometry(slider.Size())
+	point := geometry.pointAt(valueRatio(nil, 33, 0, 100))
+
+	tests := []struct {
+		name    string
+		px      float32 // Смещение указателя от точки привязки
+		fine    bool    // Зажат модификатор точной настройки
+		want    float64
+		snapped bool
+		flash   bool
+	}{
+		{"inside radius", 6, false, 33, true, true},
+		{"still captured", -6, false, 33, true, false},
+		{"outside radius", 20, false, 45, false, false},
+		{"captured again", -2, false, 33, true, true},
+		{"left on the other side", -12, false, 25, false, false},
+		{"fine drag passes the point", 3, true, 35, false, false},
+	}
+
+	for _, tt := range tests {
+		var modifiers fyne.KeyModifier
+		if tt.fine {
+			modifiers = slider.FineModifier
+		}
+		slider.flashLevel = 0
+		slider.dragPos = point.Add(fyne.NewPos(tt.px, 0))
+		slider.dragTo(fyne.NewDelta(0, 0), modifiers)
+
+		if slider.Value != tt.want {
+			t.Errorf("%s: value %v, want %v", tt.name, slider.Value, tt.want)
+		}
+		if slider.snapped != tt.snapped {
+			t.Errorf("%s: snapped %v, want %v", tt.name, slider.snapped, tt.snapped)
+		}
+		if flashed := slider.flashLevel > 0; flashed != tt.flash {
+			t.Errorf("%s: flash %v, want %v", tt.name, flashed, tt.flash)
+		}
+	}
+}
+
+func TestSnapPointOffStepGridIsValid(t *testing.T) {
+	slider := NewWithStep(0, 100, 5)
+	renderSlider(t, slider)
+	slider.SetSnapPoints(0, 33)
+
+	slider.SetValue(33)
+	if slider.Value != 33 {
+		t.Errorf("SetValue(33): got %v, want the snap point 33", slider.Value)
+	}
+	if err := slider.validateValue(33); err != nil {
+		t.Errorf("validateValue(33): %v", err)
+	}
+	if err := slider.validateValue(34); err == nil {
+		t.Error("validateValue(34) accepted a value off the step grid")
+	}
+}
@@ -0,0 +1,198 @@
+package neonslider
+
+import (
+	"math"
+	"slices"
+	"sort"
+	"strings"
+)
+
+// Stop - допустимое значение дискретного слайдера с необязательной подписью
+type Stop struct {
+	Value float64 // Значение остановки
+	Label string  // Подпись; пустая подпись заменяется отформатированным значением
+}
+
+// NewWithValues создает слайдер, принимающий только перечисленные значения,
+// например 1, 2, 5, 10, 20, 50. Значения сортируются, повторы отбрасываются,
+// остановки располагаются на треке через равные промежутки.
+func NewWithValues(values ...float64) *NeonSlider {
+	stops := make([]Stop, len(values))
+	for i, value := range values {
+		stops[i] = Stop{Value: value}
+	}
+
+	slider := New(0, 0)
+	slider.SetStops(stops)
+	return slider
+}
+
+// NewWithLabels создает слайдер с категориями, например "Low", "Medium", "High".
+// Значение слайдера - номер категории, начиная с нуля.
+func NewWithLabels(labels ...string) *NeonSlider {
+	stops := make([]Stop, len(labels))
+	for i, label := range labels {
+		stops[i] = Stop{Value: float64(i), Label: label}
+	}
+
+	slider := New(0, 0)
+	slider.SetStops(stops)
+	return slider
+}
+
+// SetStops ограничивает слайдер перечисленными значениями. Остановки сортируются,
+// из повторяющихся значений остается первое. Min и Max становятся крайними остановками,
+// а остановки располагаются на треке через равные промежутки (кусочно-линейная шкала;
+// SetScale после SetStops задает другое расположение). DefaultValue и текущее значение
+// притягиваются к ближайшей остановке. Пустой список возвращает обычный режим со Step
+// и линейную шкалу.
+func (n *NeonSlider) SetStops(stops []Stop) {
+	n.Stops = append([]Stop(nil), stops...)
+	sort.SliceStable(n.Stops, func(i, j int) bool {
+		return n.Stops[i].Value < n.Stops[j].Value
+	})
+	n.Stops = slices.CompactFunc(n.Stops, func(a, b Stop) bool {
+		return a.Value == b.Value
+	})
+
+	if len(n.Stops) > 0 {
+		n.Min = n.Stops[0].Value
+		n.Max = n.Stops[len(n.Stops)-1].Value
+		n.Scale = PiecewiseScale{Values: n.stopValues()}
+		n.DefaultValue = n.snap(n.DefaultValue)
+	} else if _, ok := n.Scale.(PiecewiseScale); ok {
+		n.Scale = nil // Шкала осталась от остановок
+	}
+	n.ticksStale = true
+	n.SetValue(n.Value)
+}
+
+// SelectedIndex возвращает номер текущей остановки или -1, если остановки не заданы
+func (n *NeonSlider) SelectedIndex() int {
+	return n.nearestStop(n.Value)
+}
+
+// SelectedLabel возвращает подпись текущей остановки или пустую строку без остановок
+func (n *NeonSlider) SelectedLabel() string {
+	index := n.SelectedIndex()
+	if index < 0 {
+		return ""
+	}
+	return n.stopLabel(index)
+}
+
+// SelectIndex переводит слайдер на остановку с номером index
+func (n *NeonSlider) SelectIndex(index int) {
+	if index < 0 || index >= len(n.Stops) {
+		return
+	}
+	n.SetValue(n.Stops[index].Value)
+}
+
+// snap ограничивает значение диапазоном и притягивает его к ближайшей остановке,
+// а без остановок - к шагу. Точки привязки не округляются к шагу.
+func (n *NeonSlider) snap(value float64) float64 {
+	if index := n.nearestStop(value); index >= 0 {
+		return n.Stops[index].Value
+	}
+	if n.isSnapPoint(value) {
+		return value
+	}
+	return snapValue(n.Scale, value, n.Min, n.Max, n.Step)
+}
+
+// nearestStop ищет ближайшую к значению остановку по положению на треке.
+// Возвращает -1, если остановки не заданы.
+func (n *NeonSlider) nearestStop(value float64) int {
+	best, bestDistance := -1, math.Inf(1)
+	ratio := valueRatio(n.Scale, value, n.Min, n.Max)
+	for i, stop := range n.Stops {
+		distance := math.Abs(valueRatio(n.Scale, stop.Value, n.Min, n.Max) - ratio)
+		if distance < bestDistance {
+			best, bestDistance = i, distance
+		}
+	}
+	return best
+}
+
+// stepStops сдвигает выбор на steps остановок, не выходя за крайние
+func (n *NeonSlider) stepStops(steps int) {
+	index := max(0, min(n.SelectedIndex()+steps, len(n.Stops)-1))
+	n.changeValue(n.Stops[index].Value)
+}
+
+// stopLabel возвращает подпись остановки или её отформатированное значение
+func (n *NeonSlider) stopLabel(index int) string {
+	if label := n.Stops[index].Label; label != "" {
+		return label
+	}
+	return n.FormatValue(n.Stops[index].Value)
+}
+
+// stopValues возвращает значения остановок в порядке возрастания
+func (n *NeonSlider) stopValues() []float64 {
+	values := make([]float64, len(n.Stops))
+	for i, stop := range n.Stops {
+		values[i] = stop.Value
+	}
+	return values
+}
+
+// stopValueFormatter возвращает форматирование с числом знаков, достаточным для всех остановок
+func (n *NeonSlider) stopValueFormatter() DecimalFormatter {
+	var decimals int
+	for _, stop := range n.Stops {
+		decimals = max(decimals, decimalPlaces(stop.Value))
+	}
+	return DecimalFormatter{Decimals: decimals}
+}
+
+// fireSelected сообщает OnSelected о выбранной остановке
+func (n *NeonSlider) fireSelected() {
+	if n.OnSelected == nil || len(n.Stops) == 0 {
+		return
+	}
+	index := n.SelectedIndex()
+	n.OnSelected(index, n.stopLabel(index))
+}
+
+// stopTicks возвращает по крупному делению на каждую остановку
+func (n *NeonSlider) stopTicks() []tick {
+	ticks := make([]tick, len(n.Stops))
+	for i, stop := range n.Stops {
+		ticks[i] = tick{
+			value: stop.Value,
+			ratio: valueRatio(n.Scale, stop.Value, n.Min, n.Max),
+			major: true,
+		}
+	}
+	return ticks
+}
+
+// stopFormatter показывает подписи остановок вместо чисел и принимает их при вводе.
+// Значения без подписи форматируются и разбираются через base.
+type stopFormatter struct {
+	stops []Stop
+	base  ValueFormatter
+}
+
+// Format возвращает подпись остановки со значением value или число
+func (f stopFormatter) Format(value float64) string {
+	for _, stop := range f.stops {
+		if stop.Value == value && stop.Label != "" {
+			return stop.Label
+		}
+	}
+	return f.base.Format(value)
+}
+
+// Parse принимает подпись остановки без учета регистра или число
+func (f stopFormatter) Parse(text string) (float64, error) {
+	trimmed := strings.TrimSpace(text)
+	for _, stop := range f.stops {
+		if stop.Label != "" && strings.EqualFold(stop.Label, trimmed) {
+			return stop.Value, nil
+		}
+	}
+	return f.base.Parse(text)
+}
@@ -0,0 +1,86 @@
+package neonslider
+
+import (
+	"testing"
+
+	"fyne.io/fyne/v2"
+)
+
+func TestStopsSnapToNearest(t *testing.T) {
+	slider := NewWithValues(5, 1, 2, 2, 10)
+	renderSlider(t, slider)
+
+	if got := slider.stopValues(); len(got) != 4 {
+		t.Fatalf("stops: got %v, want 1, 2, 5, 10", got)
+	}
+
+	tests := []struct {
+		value, want float64
+	}{
+		{0, 1},
+		{1.4, 1},
+		{2.5, 2}, // Остановки равноудалены на треке: 2.5 ближе к 2, чем к 5
+		{4, 5},
+		{7, 5},
+		{8, 10},
+		{100, 10},
+	}
+	for _, tt := range tests {
+		slider.SetValue(tt.value)
+		if slider.Value != tt.want {
+			t.Errorf("SetValue(%v): got %v, want %v", tt.value, slider.Value, tt.want)
+		}
+	}
+}
+
+func TestSetStopsMatchesNewWithValues(t *testing.T) {
+	values := NewWithValues(1, 2, 5, 10)
+	stops := New(0, 100)
+	renderSlider(t, values)
+	renderSlider(t, stops)
+	stops.SetStops([]Stop{{Value: 1}, {Value: 2}, {Value: 5}, {Value: 10}})
+
+	for _, value := range []float64{1, 2, 5, 10} {
+		want := valueRatio(values.Scale, value, values.Min, values.Max)
+		if got := valueRatio(stops.Scale, value, stops.Min, stops.Max); got != want {
+			t.Errorf("stop %v: SetStops places it at %v, NewWithValues at %v", value, got, want)
+		}
+	}
+
+	stops.SetStops(nil)
+	if stops.Scale != nil {
+		t.Errorf("scale after clearing stops: got %v, want nil", stops.Scale)
+	}
+}
+
+func TestStopsKeyboardAndOnSelected(t *testing.T) {
+	slider := NewWithLabels("Low", "Medium", "High")
+	renderSlider(t, slider)
+
+	type selection struct {
+		index int
+		label string
+	}
+	var selected []selection
+	slider.OnSelected = func(index int, label string) {
+		selected = append(selected, selection{index, label})
+	}
+
+	keys := []fyne.KeyName{fyne.KeyRight, fyne.KeyRight, fyne.KeyRight, fyne.KeyLeft, fyne.KeyHome}
+	for _, key := range keys {
+		slider.TypedKey(&fyne.KeyEvent{Name: key})
+	}
+
+	want := []selection{{1, "Medium"}, {2, "High"}, {1, "Medium"}, {0, "Low"}}
+	if len(selected) != len(want) {
+		t.Fatalf("OnSelected calls: got %v, want %v", selected, want)
+	}
+	for i := range want {
+		if selected[i] != want[i] {
+			t.Errorf("OnSelected call %d: got %v, want %v", i, selected[i], want[i])
+		}
+	}
+	if slider.SelectedLabel() != "Low" {
+		t.Errorf("SelectedLabel: got %q, want %q", slider.SelectedLabel(), "Low")
+	}
+}
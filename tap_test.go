@@ -0,0 +1,43 @@
+package neonslider
+
+import (
+	"testing"
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/test"
+)
+
+func TestDoubleTapResetsWithoutDelayingTaps(t *testing.T) {
+	if _, ok := any(&NeonSlider{}).(fyne.DoubleTappable); ok {
+		t.Fatal("NeonSlider is fyne.DoubleTappable: Fyne would delay every tap")
+	}
+
+	test.NewApp()
+	slider := NewWithStep(0, 100, 1)
+	slider.SetDefaultValue(50)
+	slider.Resize(fyne.NewSize(200, 40))
+	renderSlider(t, slider)
+
+	var ended []float64
+	slider.OnChangeEnded = func(value float64) { ended = append(ended, value) }
+
+	near := fyne.NewPos(20, slider.thumbCenter.Y)
+	slider.Tapped(&fyne.PointEvent{Position: near})
+	if slider.Value >= 50 || len(ended) != 1 {
+		t.Fatalf("single tap: value %v, OnChangeEnded %v; want an immediate move", slider.Value, ended)
+	}
+	moved := slider.Value
+
+	slider.Tapped(&fyne.PointEvent{Position: near})
+	if slider.Value != 50 || len(ended) != 2 {
+		t.Errorf("double tap: value %v, OnChangeEnded %v; want reset to 50", slider.Value, ended)
+	}
+
+	// Нажатие после интервала двойного нажатия снова просто двигает ползунок
+	slider.lastTap = time.Now().Add(-time.Second)
+	slider.Tapped(&fyne.PointEvent{Position: near})
+	if slider.Value != moved {
+		t.Errorf("late second tap: got %v, want %v", slider.Value, moved)
+	}
+}
@@ -0,0 +1,247 @@
+package neonslider
+
+import (
+	"math"
+	"sort"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+)
+
+// TickPlacement задает расположение делений шкалы относительно трека
+type TickPlacement int
+
+const (
+	TicksNone  TickPlacement = iota // Деления не рисуются (по умолчанию)
+	TicksAbove                      // Над треком (слева у вертикального слайдера)
+	TicksBelow                      // Под треком (справа у вертикального слайдера)
+)
+
+// Параметры делений шкалы
+const (
+	maxMajorTicks      = 10 // Наибольшее число крупных интервалов при автоматическом выборе
+	maxMinorTicks      = 50 // Наибольшее число мелких интервалов при автоматическом выборе
+	autoMajorDivisions = 4  // Крупных интервалов на треке без шага
+	autoMinorDivisions = 5  // Мелких интервалов в каждом крупном без шага
+
+	majorTickLength = float32(8)  // Длина крупного деления
+	minorTickLength = float32(4)  // Длина мелкого деления
+	tickGap         = float32(4)  // Отступ делений от края дорожки
+	tickLabelGap    = float32(2)  // Отступ подписи от крупного деления
+	tickLabelSize   = float32(10) // Размер шрифта подписей
+)
+
+// tick - деление шкалы
+type tick struct {
+	value float64 // Значение деления
+	ratio float64 // Положение на треке (0.0-1.0)
+	major bool    // Крупное деление, подписывается при TickLabels
+}
+
+// SetTickPlacement задает расположение делений шкалы; TicksNone скрывает их
+func (n *NeonSlider) SetTickPlacement(placement TickPlacement) {
+	n.TickPlacement = placement
+	n.Refresh()
+}
+
+// SetTicks задает значения крупных и мелких делений.
+// Если оба списка пусты, деления выводятся из остановок или шага.
+func (n *NeonSlider) SetTicks(major, minor []float64) {
+	n.MajorTicks = major
+	n.MinorTicks = minor
+	n.Refresh()
+}
+
+// SetTickLabels включает подписи крупных делений, отформатированные через Formatter
+func (n *NeonSlider) SetTickLabels(show bool) {
+	n.TickLabels = show
+	n.Refresh()
+}
+
+// ticks возвращает деления шкалы в порядке возрастания положения на треке
+func (n *NeonSlider) ticks() []tick {
+	if n.TickPlacement == TicksNone || !(n.Max > n.Min) {
+		return nil
+	}
+	if len(n.MajorTicks) > 0 || len(n.MinorTicks) > 0 {
+		return n.explicitTicks()
+	}
+	if len(n.Stops) > 0 {
+		return n.stopTicks()
+	}
+	return n.autoTicks()
+}
+
+// explicitTicks переводит заданные значения делений в положения на треке
+func (n *NeonSlider) explicitTicks() []tick {
+	ticks := make([]tick, 0, len(n.MajorTicks)+len(n.MinorTicks))
+	add := func(values []float64, major bool) {
+		for _, value := range values {
+			if value < n.Min || value > n.Max {
+				continue
+			}
+			ticks = append(ticks, tick{
+				value: value,
+				ratio: valueRatio(n.Scale, value, n.Min, n.Max),
+				major: major,
+			})
+		}
+	}
+	add(n.MinorTicks, false)
+	add(n.MajorTicks, true)
+	sort.SliceStable(ticks, func(i, j int) bool {
+		return ticks[i].ratio < ticks[j].ratio
+	})
+	return ticks
+}
+
+// autoTicks расставляет деления по шагу в пространстве шкалы: каждый шаг - мелкое
+// деление, каждый k-й - крупное. Без шага трек делится на равные интервалы.
+func (n *NeonSlider) autoTicks() []tick {
+	steps := float64(autoMajorDivisions * autoMinorDivisions)
+	majorEvery := float64(autoMinorDivisions)
+	span := 1 / steps // Доля трека на один интервал
+	if n.Step > 0 {
+		low, high := scaledRange(scaleFor(n.Scale, n.Min, n.Max), n.Min, n.Max)
+		steps = math.Floor((high-low)/n.Step + 1e-9)
+		if math.IsNaN(steps) || steps < 1 {
+			return nil
+		}
+		majorEvery = math.Ceil(steps / maxMajorTicks)
+		span = n.Step / (high - low)
+	}
+
+	// Слишком частые мелкие деления сливаются - тогда рисуем только крупные
+	increment := 1.0
+	if steps > maxMinorTicks {
+		increment = majorEvery
+	}
+
+	var ticks []tick
+	for i := 0.0; i <= steps; i += increment {
+		ratio := math.Min(i*span, 1)
+		// Округление к шагу убирает накопленную ошибку вроде 30.000000000000004
+		value := snapValue(n.Scale, ratioValue(n.Scale, ratio, n.Min, n.Max), n.Min, n.Max, n.Step)
+		ticks = append(ticks, tick{
+			value: value,
+			ratio: ratio,
+			major: math.Mod(i, majorEvery) == 0,
+		})
+	}
+	return ticks
+}
+
+// updateTicks пересчитывает деления и приводит число линий и подписей к их количеству.
+// Вызывается при создании рендерера и после NeonSlider.Refresh; кадры анимации
+// и изменения значения только перекрашивают деления.
+func (r *neonSliderRenderer) updateTicks() {
+	r.slider.ticksStale = false
+	r.ticks = r.slider.ticks()
+
+	for len(r.tickLines) < len(r.ticks) {
+		r.tickLines = append(r.tickLines, canvas.NewLine(nil))
+		label := canvas.NewText("", nil)
+		label.TextSize = tickLabelSize
+		label.TextStyle = fyne.TextStyle{Bold: true}
+		r.tickLabels = append(r.tickLabels, label)
+	}
+	r.tickLines = r.tickLines[:len(r.ticks)]
+	r.tickLabels = r.tickLabels[:len(r.ticks)]
+
+	for i, t := range r.ticks {
+		label := r.tickLabels[i]
+		if t.major && r.slider.TickLabels {
+			label.Text = r.slider.FormatValue(t.value)
+			label.Show()
+		} else {
+			label.Hide()
+		}
+	}
+}
+
+// applyTicks раскрашивает деления: пройденные заливкой светятся её цветом
+func (r *neonSliderRenderer) applyTicks(palette *neonPalette, fillRatio float64) {
+	for i, t := range r.ticks {
+		line, label := r.tickLines[i], r.tickLabels[i]
+		passed := t.ratio <= fillRatio+1e-9
+
+		width := float32(1)
+		if t.major {
+			width = 2
+		}
+		if passed {
+			line.StrokeColor = palette.fillStroke
+			label.Color = palette.fillStroke
+			width++
+		} else {
+			line.StrokeColor = palette.trackStroke
+			label.Color = palette.trackStroke
+		}
+		line.StrokeWidth = width
+
+		canvas.Refresh(line)
+		canvas.Refresh(label)
+	}
+}
+
+// layoutTicks размещает деления и подписи вдоль трека на стороне TickPlacement
+func (r *neonSliderRenderer) layoutTicks(geometry trackGeometry) {
+	side := float32(-1) // Над треком или слева от него
+	if r.slider.TickPlacement == TicksBelow {
+		side = 1
+	}
+	start := trackThickness/2 + tickGap
+
+	for i, t := range r.ticks {
+		line, label := r.tickLines[i], r.tickLabels[i]
+		length := minorTickLength
+		if t.major {
+			length = majorTickLength
+		}
+
+		center := geometry.pointAt(t.ratio)
+		labelSize := label.MinSize()
+		labelOffset := start + majorTickLength + tickLabelGap
+		if geometry.orientation == Vertical {
+			line.Position1 = fyne.NewPos(center.X+side*start, center.Y)
+			line.Position2 = fyne.NewPos(center.X+side*(start+length), center.Y)
+
+			x := center.X + labelOffset
+			if side < 0 {
+				x = center.X - labelOffset - labelSize.Width
+			}
+			label.Move(fyne.NewPos(x, center.Y-labelSize.Height/2))
+		} else {
+			line.Position1 = fyne.NewPos(center.X, center.Y+side*start)
+			line.Position2 = fyne.NewPos(center.X, center.Y+side*(start+length))
+
+			y := center.Y + labelOffset
+			if side < 0 {
+				y = center.Y - labelOffset - labelSize.Height
+			}
+			label.Move(fyne.NewPos(center.X-labelSize.Width/2, y))
+		}
+		label.Resize(labelSize)
+	}
+}
+
+// tickExtent возвращает, насколько деления с подписями выступают от центра трека
+// поперек оси слайдера
+func (r *neonSliderRenderer) tickExtent() float32 {
+	if len(r.ticks) == 0 {
+		return 0
+	}
+	extent := trackThickness/2 + tickGap + majorTickLength
+	for _, label := range r.tickLabels {
+		if !label.Visible() {
+			continue
+		}
+		size := label.MinSize()
+		across := size.Height
+		if r.slider.Orientation == Vertical {
+			across = size.Width
+		}
+		extent = max(extent, trackThickness/2+tickGap+majorTickLength+tickLabelGap+across)
+	}
+	return extent
+}
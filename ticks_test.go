@@ -0,0 +1,59 @@
+package neonslider
+
+import (
+	"testing"
+
+	"fyne.io/fyne/v2/test"
+)
+
+func TestTicksRebuiltOnlyOnSettingsChange(t *testing.T) {
+	test.NewApp()
+	slider := NewWithStep(0, 100, 0.5)
+	slider.SetTickPlacement(TicksBelow)
+	slider.SetTickLabels(true)
+	slider.SetScale(PiecewiseScale{Values: []float64{0, 10, 100}})
+	r := renderSlider(t, slider)
+
+	first := &r.ticks[0]
+	slider.animateFrame()
+	slider.SetValue(30)
+	if &r.ticks[0] != first {
+		t.Fatal("animation frame or value change rebuilt the ticks")
+	}
+
+	tests := []struct {
+		name   string
+		change func()
+		count  int
+	}{
+		{"step field", func() { slider.Step = 0.25 }, 9},
+		{"explicit ticks", func() { slider.SetTicks([]float64{0, 50, 100}, nil) }, 3},
+		{"ticks changed in place", func() { slider.MajorTicks[1] = 200 }, 2},
+		{"scale", func() { slider.SetScale(LinearScale{}) }, 2},
+		{"range field", func() { slider.Max = 10 }, 1},
+		{"placement", func() { slider.SetTickPlacement(TicksNone) }, 0},
+	}
+
+	for _, tt := range tests {
+		tt.change()
+		slider.Refresh()
+		if len(r.ticks) != tt.count {
+			t.Errorf("%s: got %d ticks, want %d", tt.name, len(r.ticks), tt.count)
+		}
+	}
+}
+
+func TestExplicitTicksSorted(t *testing.T) {
+	slider := New(0, 100)
+	slider.TickPlacement = TicksBelow
+	slider.MajorTicks = []float64{100, 0, 50}
+	slider.MinorTicks = []float64{75, 25}
+
+	var previous float64 = -1
+	for _, tick := range slider.ticks() {
+		if tick.ratio < previous {
+			t.Fatalf("ticks out of order: %v", slider.ticks())
+		}
+		previous = tick.ratio
+	}
+}
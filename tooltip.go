@@ -0,0 +1,124 @@
+package neonslider
+
+import (
+	"image/color"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+)
+
+// TooltipMode задает, когда над ползунком показывается подсказка со значением
+type TooltipMode int
+
+const (
+	TooltipNever         TooltipMode = iota // Подсказка не показывается (по умолчанию)
+	TooltipOnInteraction                    // При наведении и во время перетаскивания
+	TooltipAlways                           // Всегда, в том числе у отключенного слайдера
+)
+
+// Параметры подсказки со значением
+const (
+	tooltipTextSize = float32(12)        // Размер шрифта
+	tooltipPadding  = float32(6)         // Внутренний отступ по горизонтали
+	tooltipGap      = float32(6)         // Отступ от края ползунка
+	tooltipRise     = float32(6)         // Сдвиг подсказки при появлении
+	tooltipRadius   = float32(6)         // Радиус скругления
+	tooltipStroke   = float32(1.5)       // Толщина неоновой рамки
+	tooltipVPadding = tooltipPadding / 2 // Внутренний отступ по вертикали
+)
+
+// SetTooltip задает режим подсказки со значением над ползунком
+func (n *NeonSlider) SetTooltip(mode TooltipMode) {
+	n.Tooltip = mode
+	n.hoverChanged()
+	n.Refresh()
+}
+
+// tooltipTarget возвращает целевую видимость подсказки (0.0-1.0)
+func (n *NeonSlider) tooltipTarget() float64 {
+	switch n.Tooltip {
+	case TooltipAlways:
+		return 1
+	case TooltipOnInteraction:
+		if n.interactive() && (n.hovered || n.isDragging) {
+			return 1
+		}
+	}
+	return 0
+}
+
+// applyTooltip раскрашивает подсказку и обновляет её текст
+func (r *neonSliderRenderer) applyTooltip(palette *neonPalette) {
+	level := smoothstep(r.slider.tooltipLevel)
+	if level <= 0 {
+		r.tooltip.Hide()
+		r.tooltipText.Hide()
+		return
+	}
+
+	// Прозрачность растет вместе с уровнем появления
+	fade := func(c color.Color) color.Color {
+		return mixColor(color.Transparent, c, level)
+	}
+	r.tooltip.FillColor = fade(palette.trackFill)
+	r.tooltip.StrokeColor = fade(palette.fillStroke)
+	r.tooltipText.Color = fade(palette.fillStroke)
+	r.tooltipText.Text = r.slider.ValueText()
+
+	r.tooltip.Show()
+	r.tooltipText.Show()
+	canvas.Refresh(r.tooltip)
+	canvas.Refresh(r.tooltipText)
+}
+
+// layoutTooltip размещает подсказку над ползунком (справа у вертикального слайдера).
+// Появляясь, подсказка выплывает от ползунка на tooltipRise.
+func (r *neonSliderRenderer) layoutTooltip() {
+	textSize := r.tooltipText.MinSize()
+	size := fyne.NewSize(textSize.Width+tooltipPadding*2, textSize.Height+tooltipVPadding*2)
+
+	center := r.slider.thumbCenter
+	offset := r.slider.thumbSize/2 + tooltipGap + tooltipRise*float32(smoothstep(r.slider.tooltipLevel))
+	var pos fyne.Position
+	if r.slider.Orientation == Vertical {
+		pos = fyne.NewPos(center.X+offset, center.Y-size.Height/2)
+	} else {
+		pos = fyne.NewPos(center.X-size.Width/2, center.Y-offset-size.Height)
+	}
+
+	r.tooltip.Resize(size)
+	r.tooltip.Move(pos)
+	r.tooltipText.Resize(textSize)
+	r.tooltipText.Move(pos.Add(fyne.NewPos(tooltipPadding, tooltipVPadding)))
+}
+
+// tooltipExtent возвращает, насколько подсказка выступает от центра трека поперек оси.
+// Ширина оценивается по границам диапазона, чтобы размер виджета не менялся вместе со значением.
+func (r *neonSliderRenderer) tooltipExtent() float32 {
+	if r.slider.Tooltip == TooltipNever {
+		return 0
+	}
+	style := r.tooltipText.TextStyle
+	minSize := fyne.MeasureText(r.slider.FormatValue(r.slider.Min), tooltipTextSize, style)
+	maxSize := fyne.MeasureText(r.slider.FormatValue(r.slider.Max), tooltipTextSize, style)
+
+	across := max(minSize.Height, maxSize.Height) + tooltipVPadding*2
+	if r.slider.Orientation == Vertical {
+		across = max(minSize.Width, maxSize.Width) + tooltipPadding*2
+	}
+	return r.slider.thumbSize/2 + tooltipGap + tooltipRise + across
+}
+
+// newTooltip создает скрытую подсказку: неоновую рамку и текст значения
+func newTooltip() (*canvas.Rectangle, *canvas.Text) {
+	bubble := canvas.NewRectangle(color.Transparent)
+	bubble.CornerRadius = tooltipRadius
+	bubble.StrokeWidth = tooltipStroke
+	bubble.Hide()
+
+	text := canvas.NewText("", color.Transparent)
+	text.TextSize = tooltipTextSize
+	text.TextStyle = fyne.TextStyle{Bold: true}
+	text.Hide()
+	return bubble, text
+}